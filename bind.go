@@ -0,0 +1,156 @@
+package gtml
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+// Bindable is a value that can be bound to an input value
+type Bindable interface {
+	~string |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// BindValue binds the value of an input, a textarea or a select to
+// the state, the value follows the state and the state is set each
+// time the user changes the value, converted to the state type (e.g.
+// an int for a number input), the values that can't be converted
+// (e.g. an empty number input) are ignored
+//
+// NOTE: the value is set when the state changes, the element
+// doesn't have to be rendered again (e.g. in a reactive element)
+//
+// example:
+//
+//	name := gtml.UseState(app, "")
+//
+//	app.Reactive(func() Element {
+//		return Div()(
+//			Input(gtml.BindValue(name))(),
+//			Textf("hello %s", name.Get()),
+//		)
+//	})
+func BindValue[T Bindable](state *State[T]) elements.Attribute {
+	return bind(state, "value", "input",
+		func(v T) interface{} { return formatBindable(v) },
+		func(value interface{}) (T, bool) {
+			v, err := parseBindable[T](fmt.Sprint(value))
+			return v, err == nil
+		},
+	)
+}
+
+// BindChecked binds the checked state of a checkbox or radio input
+func BindChecked(state *State[bool]) elements.Attribute {
+	return bind(state, "checked", "change",
+		func(checked bool) interface{} { return checked },
+		func(value interface{}) (bool, bool) {
+			checked, ok := value.(bool)
+			return checked, ok
+		},
+	)
+}
+
+// BindSelected binds the values of the selected options of a
+// multiple select, see BindValue for a single select
+func BindSelected[T Bindable](state *State[[]T]) elements.Attribute {
+	return bind(state, elements.SelectedValues, "change",
+		func(selected []T) interface{} {
+			values := make([]string, len(selected))
+			for i, v := range selected {
+				values[i] = formatBindable(v)
+			}
+			return values
+		},
+		func(value interface{}) ([]T, bool) {
+			values, _ := value.([]string)
+
+			selected := make([]T, 0, len(values))
+			for _, value := range values {
+				v, err := parseBindable[T](value)
+				if err != nil {
+					return nil, false
+				}
+				selected = append(selected, v)
+			}
+
+			return selected, true
+		},
+	)
+}
+
+// bind returns the binding of the dom property to the state, format
+// gives the property value of the state one and parse the state value
+// of the property one (false if it can't be converted)
+func bind[T any](
+	state *State[T],
+	property, event string,
+	format func(T) interface{},
+	parse func(value interface{}) (T, bool),
+) elements.Attribute {
+	// the value set by the user isn't set back in the node when
+	// the state changes (e.g. "1.50" would be formatted to "1.5")
+	setting := false
+
+	return elements.Attribute{
+		Name: "Bind",
+		Value: &elements.Binding{
+			Property: property,
+			Event:    event,
+			// like css.Bind, the state isn't tracked, the
+			// property follows it without rendering again
+			Value: format(state.Peek()),
+			Set: func(value interface{}) {
+				v, ok := parse(value)
+				if !ok {
+					return
+				}
+
+				setting = true
+				state.Set(v)
+				setting = false
+			},
+			Watch: func(set func(value interface{})) func() {
+				return state.Subscribe(func(v T) {
+					if !setting {
+						set(format(v))
+					}
+				})
+			},
+		},
+	}
+}
+
+func formatBindable[T Bindable](v T) string {
+	value := reflect.ValueOf(v)
+
+	switch value.Kind() {
+	case reflect.Float32:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	}
+
+	return fmt.Sprint(v)
+}
+
+func parseBindable[T Bindable](s string) (T, error) {
+	value := reflect.New(reflect.TypeOf((*T)(nil)).Elem()).Elem()
+
+	// an empty text is the zero value for SetValueString,
+	// but an empty number input isn't a number
+	if s == "" && value.Kind() != reflect.String {
+		return value.Interface().(T), fmt.Errorf("empty %s", value.Type())
+	}
+
+	if err := elements.SetValueString(value, s); err != nil {
+		return value.Interface().(T), err
+	}
+
+	return value.Interface().(T), nil
+}
@@ -0,0 +1,131 @@
+package gtml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name string
+		// bind returns the bound element and sets the state
+		bind     func(app *App) (elements.Element, func(), func() interface{})
+		property string
+		// input is the value typed by the user
+		input    interface{}
+		event    string
+		want     interface{}
+		wantNode interface{}
+	}{
+		{
+			name: "value",
+			bind: func(app *App) (elements.Element, func(), func() interface{}) {
+				n := UseState(app, 0.0)
+				return elements.Input(BindValue(n))(), func() { n.Set(2.5) }, func() interface{} { return n.Peek() }
+			},
+			property: "value",
+			input:    "1.50",
+			event:    "input",
+			want:     1.5,
+			wantNode: "2.5",
+		},
+		{
+			name: "checked",
+			bind: func(app *App) (elements.Element, func(), func() interface{}) {
+				checked := UseState(app, false)
+				return elements.Input(elements.Type("checkbox"), BindChecked(checked))(),
+					func() { checked.Set(true) }, func() interface{} { return checked.Peek() }
+			},
+			property: "checked",
+			input:    false,
+			event:    "change",
+			want:     false,
+			wantNode: true,
+		},
+		{
+			name: "selected",
+			bind: func(app *App) (elements.Element, func(), func() interface{}) {
+				selected := UseState(app, []string{})
+				return elements.Select(elements.Multiple, BindSelected(selected))(
+						elements.Option(elements.Value("a"))(),
+						elements.Option(elements.Value("b"))(),
+					),
+					func() { selected.Set([]string{"a", "b"}) }, func() interface{} { return selected.Peek() }
+			},
+			property: elements.SelectedValues,
+			input:    []string{"b"},
+			event:    "change",
+			want:     []string{"b"},
+			wantNode: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := NewApp()
+			el, set, value := test.bind(app)
+
+			// the element isn't reactive, the binding follows the state
+			body := mountApp(app, el)
+			node := body.Children[0]
+
+			set()
+			if got := elements.GetDOM().GetProperty(node, test.property); !reflect.DeepEqual(got, test.wantNode) {
+				t.Errorf("the node %s is %v, want %v", test.property, got, test.wantNode)
+			}
+
+			// the value typed by the user is kept as is in the node
+			elements.GetDOM().SetProperty(node, test.property, test.input)
+			node.Dispatch(test.event)
+
+			if got := value(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("the state is %v, want %v", got, test.want)
+			}
+			if got := elements.GetDOM().GetProperty(node, test.property); !reflect.DeepEqual(got, test.input) {
+				t.Errorf("the node %s is %v, want %v", test.property, got, test.input)
+			}
+
+			// the state isn't watched anymore once the element is removed
+			elements.Unmount(el)
+			set()
+
+			if got := elements.GetDOM().GetProperty(node, test.property); !reflect.DeepEqual(got, test.input) {
+				t.Errorf("the removed node %s is %v, want %v", test.property, got, test.input)
+			}
+		})
+	}
+}
+
+func TestParseBindable(t *testing.T) {
+	type name string
+
+	tests := []struct {
+		name  string
+		parse func() (interface{}, error)
+		want  interface{}
+		err   bool
+	}{
+		{name: "string", parse: func() (interface{}, error) { return parseBindable[string]("a") }, want: "a"},
+		{name: "empty string", parse: func() (interface{}, error) { return parseBindable[string]("") }, want: ""},
+		{name: "named string", parse: func() (interface{}, error) { return parseBindable[name]("bob") }, want: name("bob")},
+		{name: "int", parse: func() (interface{}, error) { return parseBindable[int]("-4") }, want: -4},
+		{name: "empty int", parse: func() (interface{}, error) { return parseBindable[int]("") }, err: true},
+		{name: "invalid int", parse: func() (interface{}, error) { return parseBindable[int]("4a") }, err: true},
+		{name: "uint8 overflow", parse: func() (interface{}, error) { return parseBindable[uint8]("256") }, err: true},
+		{name: "float", parse: func() (interface{}, error) { return parseBindable[float64]("1.50") }, want: 1.5},
+	}
+
+	for _, test := range tests {
+		got, err := test.parse()
+
+		if (err != nil) != test.err {
+			t.Errorf("%s: got error %v, want error %v", test.name, err, test.err)
+			continue
+		}
+		if !test.err && got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/4lxprime/gtml/document"
+	"github.com/4lxprime/gtml/internal/files"
+)
+
+// runBuild builds the app in the output directory: the static
+// directory is copied, the app is built to main.wasm, the
+// wasm_exec.js of the installed go is added and the index.html
+// is generated if the static directory doesn't have one
+func runBuild(args []string) error {
+	flags := flag.NewFlagSet("build", flag.ExitOnError)
+	output := flags.String("o", "dist", "output directory")
+	title := flags.String("title", "", "title of the generated index.html (default: the directory name)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: gtml build [-o dist] [-title name] [dir]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	dir := "."
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	if err := build(dir, *output, *title); err != nil {
+		return err
+	}
+
+	fmt.Printf("app built in %s\n", *output)
+
+	return nil
+}
+
+func build(dir, output, title string) error {
+	if err := os.MkdirAll(output, 0o755); err != nil {
+		return err
+	}
+
+	static := filepath.Join(dir, "static")
+	if _, err := os.Stat(static); err == nil {
+		if err := files.CopyDir(static, output); err != nil {
+			return fmt.Errorf("copy static: %w", err)
+		}
+	}
+
+	if err := buildWasm(dir, filepath.Join(output, "main.wasm")); err != nil {
+		return err
+	}
+
+	if err := copyWasmExec(output); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(static, "index.html")); err == nil {
+		return nil
+	}
+
+	return writeIndex(dir, output, title)
+}
+
+// writeIndex generates the page hosting the app, the wasm
+// url has the app hash so the browsers don't keep an old one
+func writeIndex(dir, output, title string) error {
+	if title == "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		title = filepath.Base(abs)
+	}
+
+	hash, err := document.HashFile(filepath.Join(output, "main.wasm"))
+	if err != nil {
+		return err
+	}
+
+	doc := document.New(title)
+	doc.WasmHash = hash
+
+	return doc.WriteFile(filepath.Join(output, "index.html"))
+}
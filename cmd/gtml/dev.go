@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/4lxprime/gtml/document"
+)
+
+// devScript is injected in the html pages served by the dev server,
+// it shows the build errors in an overlay and reloads the page
+// when the app is rebuilt
+const devScript = `<script>
+	window.%s = true;
+	(() => {
+		const preserveState = %t;
+		const events = new EventSource("/_gtml/events");
+
+		events.addEventListener("reload", () => {
+			if (preserveState && typeof %[3]s === "function") %[3]s();
+			location.reload();
+		});
+
+		events.addEventListener("build-error", (e) => {
+			let overlay = document.getElementById("gtml-error-overlay");
+			if (!overlay) {
+				overlay = document.createElement("pre");
+				overlay.id = "gtml-error-overlay";
+				overlay.style.cssText = "position:fixed;inset:0;z-index:2147483647;margin:0;padding:2em;" +
+					"overflow:auto;background:rgba(20,20,20,.95);color:#ff6b6b;font:14px monospace;white-space:pre-wrap";
+				document.documentElement.appendChild(overlay);
+			}
+			overlay.textContent = e.data;
+		});
+	})();
+</script>
+`
+
+// runDev builds the app, serves it and rebuilds it each
+// time a file changes, the pages are reloaded after
+func runDev(args []string) error {
+	flags := flag.NewFlagSet("dev", flag.ExitOnError)
+	addr := flags.String("addr", ":8000", "address to listen on")
+	preserveState := flags.Bool("preserve-state", false, "keep the states values across the reloads")
+	interval := flags.Duration("interval", 500*time.Millisecond, "files polling interval")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: gtml dev [-addr :8000] [-preserve-state] [dir]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	dir := "."
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	output, err := os.MkdirTemp("", "gtml-dev")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(output)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	d := &devServer{
+		dir:           dir,
+		output:        output,
+		preserveState: *preserveState,
+		files:         fileServer(output),
+		clients:       make(map[chan devEvent]struct{}),
+	}
+	d.rebuild()
+
+	go d.watch(ctx, *interval)
+
+	server := &http.Server{Addr: *addr, Handler: d}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	log.Printf("dev server on %s", *addr)
+
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+type devEvent struct {
+	name string
+	data string
+}
+
+type devServer struct {
+	dir           string
+	output        string
+	preserveState bool
+	// files serves the built files other than the pages
+	files http.Handler
+
+	mutex sync.Mutex
+	// last build error, sent to the new pages
+	buildErr error
+	clients  map[chan devEvent]struct{}
+}
+
+func (d *devServer) rebuild() {
+	start := time.Now()
+	err := build(d.dir, d.output, "")
+
+	d.mutex.Lock()
+	d.buildErr = err
+	d.mutex.Unlock()
+
+	if err != nil {
+		log.Printf("build failed:\n%v", err)
+		d.broadcast(devEvent{name: "build-error", data: err.Error()})
+		return
+	}
+
+	log.Printf("built in %v", time.Since(start).Round(time.Millisecond))
+	d.broadcast(devEvent{name: "reload"})
+}
+
+// watch polls the files of the app and rebuilds it when
+// one of them is changed, added or removed
+func (d *devServer) watch(ctx context.Context, interval time.Duration) {
+	last := d.fingerprint()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if current := d.fingerprint(); current != last {
+				last = current
+				d.rebuild()
+			}
+		}
+	}
+}
+
+// fingerprint summarizes the go files and the static files
+// of the app, it changes when one of them is changed
+func (d *devServer) fingerprint() string {
+	var b strings.Builder
+
+	filepath.WalkDir(d.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		// hidden directories (e.g. .git) are not watched
+		if entry.IsDir() && path != d.dir && strings.HasPrefix(entry.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		isStatic := strings.HasPrefix(path, filepath.Join(d.dir, "static")+string(filepath.Separator))
+		if entry.IsDir() || (!isStatic && !strings.HasSuffix(path, ".go") && entry.Name() != "go.mod") {
+			return nil
+		}
+
+		if info, err := entry.Info(); err == nil {
+			fmt.Fprintf(&b, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+		}
+
+		return nil
+	})
+
+	return b.String()
+}
+
+func (d *devServer) broadcast(event devEvent) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for client := range d.clients {
+		select {
+		case client <- event:
+		default:
+		}
+	}
+}
+
+func (d *devServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.URL.Path == "/_gtml/events" {
+		d.serveEvents(w, r)
+		return
+	}
+
+	ext := path.Ext(r.URL.Path)
+	if ext != "" && ext != ".html" {
+		d.files.ServeHTTP(w, r)
+		return
+	}
+
+	d.servePage(w, r)
+}
+
+// servePage serves the html page with the dev script, index.html
+// is served for the paths without a file like in fileServer
+func (d *devServer) servePage(w http.ResponseWriter, r *http.Request) {
+	name := filepath.Join(d.output, filepath.FromSlash(path.Clean("/"+r.URL.Path)))
+	if info, err := os.Stat(name); err != nil || info.IsDir() {
+		if err == nil {
+			name = filepath.Join(name, "index.html")
+		}
+
+		if _, err := os.Stat(name); err != nil {
+			name = filepath.Join(d.output, "index.html")
+		}
+	}
+
+	page, err := os.ReadFile(name)
+	if err != nil {
+		// the first build failed, there is no page yet
+		page = []byte("<!DOCTYPE html><html><head></head><body></body></html>")
+	}
+
+	script := fmt.Sprintf(devScript, document.DevGlobal, d.preserveState, document.SaveStateFunc)
+
+	// the dev script must run before the app
+	html := string(page)
+	if i := strings.Index(html, "<head>"); i >= 0 {
+		html = html[:i+len("<head>")] + script + html[i+len("<head>"):]
+	} else {
+		html = script + html
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(html))
+}
+
+// serveEvents sends the reload and build error events to a page
+func (d *devServer) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+
+	client := make(chan devEvent, 8)
+
+	d.mutex.Lock()
+	d.clients[client] = struct{}{}
+	buildErr := d.buildErr
+	d.mutex.Unlock()
+
+	defer func() {
+		d.mutex.Lock()
+		delete(d.clients, client)
+		d.mutex.Unlock()
+	}()
+
+	if buildErr != nil {
+		writeEvent(w, devEvent{name: "build-error", data: buildErr.Error()})
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case event := <-client:
+			writeEvent(w, event)
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event devEvent) {
+	fmt.Fprintf(w, "event: %s\n", event.name)
+
+	// every line of the data is sent in its own data field
+	for _, line := range strings.Split(event.data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+
+	fmt.Fprint(w, "\n")
+}
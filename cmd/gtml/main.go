@@ -0,0 +1,55 @@
+// Command gtml creates, builds and serves gtml apps
+//
+// usage:
+//
+//	gtml new [-module name] <dir>
+//	gtml build [-o dist] [dir]
+//	gtml serve [-addr :8000] [dist]
+//	gtml dev [-addr :8000] [-preserve-state] [dir]
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `gtml creates, builds and serves gtml apps
+
+usage:
+
+	gtml <command> [arguments]
+
+commands:
+
+	new     create a new app
+	build   build the app in a dist directory
+	serve   serve a built app
+	dev     serve the app and rebuild it when a file changes
+
+run "gtml <command> -h" for the command arguments
+`
+
+var commands = map[string]func(args []string) error{
+	"new":   runNew,
+	"build": runBuild,
+	"serve": runServe,
+	"dev":   runDev,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "gtml: unknown command %s\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err := command(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "gtml:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+const mainTemplate = `//go:build js && wasm
+
+package main
+
+import (
+	"github.com/4lxprime/gtml"
+	. "github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/runtime"
+)
+
+func Index(app *gtml.App) *gtml.App {
+	count := gtml.UseState(app, 0)
+
+	return app.Use(app.Reactive(func() Element {
+		return Div()(
+			P()(
+				Textf("clicked %d times", count.Get()),
+			),
+			Button(
+				OnClick(func() {
+					count.Update(func(c int) int { return c + 1 })
+				}),
+			)(
+				Text("Click"),
+			),
+		)
+	}))
+}
+
+func main() {
+	runtime.Runtime(
+		Index(
+			gtml.NewApp(),
+		),
+	)
+}
+`
+
+// runNew creates an app in a new directory with its go module, a
+// main.go and the static directory, the index.html is generated
+// by gtml build unless one is added in the static directory
+func runNew(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ExitOnError)
+	module := flags.String("module", "", "module path (default: the directory name)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: gtml new [-module name] <dir>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	dir := flags.Arg(0)
+	if *module == "" {
+		*module = filepath.Base(dir)
+	}
+
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	}
+
+	static := filepath.Join(dir, "static")
+	if err := os.MkdirAll(static, 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(dir, "main.go"),
+		[]byte(mainTemplate),
+		0o644,
+	); err != nil {
+		return err
+	}
+
+	if err := copyWasmExec(static); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "mod", "init", *module)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go mod init: %w", err)
+	}
+
+	fmt.Printf(
+		"app created in %s, to run it:\n\n\tcd %s\n\tgo get github.com/4lxprime/gtml\n\tgtml build\n\tgtml serve\n",
+		dir, dir,
+	)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// runServe serves a built app
+func runServe(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", ":8000", "address to listen on")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: gtml serve [-addr :8000] [dist]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	dir := "dist"
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	log.Printf("serving %s on %s", dir, *addr)
+
+	return http.ListenAndServe(*addr, fileServer(dir))
+}
+
+// fileServer serves the files of dir, the wasm files with their
+// mime type, and index.html for the paths without a file so
+// the router can handle them (e.g. /users/1)
+func fileServer(dir string) http.Handler {
+	mime.AddExtensionType(".wasm", "application/wasm")
+
+	files := http.FileServer(http.Dir(dir))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+r.URL.Path)))
+
+		if _, err := os.Stat(name); os.IsNotExist(err) && path.Ext(r.URL.Path) == "" {
+			http.ServeFile(w, r, filepath.Join(dir, "index.html"))
+			return
+		}
+
+		files.ServeHTTP(w, r)
+	})
+}
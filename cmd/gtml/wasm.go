@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/4lxprime/gtml/internal/files"
+)
+
+// wasmExec returns the path of the wasm_exec.js of the installed
+// go, it must match the go version the app is built with
+func wasmExec() (string, error) {
+	out, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return "", fmt.Errorf("go env GOROOT: %w", err)
+	}
+	goroot := strings.TrimSpace(string(out))
+
+	// moved from misc/wasm to lib/wasm in go 1.24
+	for _, dir := range []string{"lib/wasm", "misc/wasm"} {
+		path := filepath.Join(goroot, filepath.FromSlash(dir), "wasm_exec.js")
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("wasm_exec.js not found in %s", goroot)
+}
+
+// copyWasmExec copies the wasm_exec.js of the installed go in dir
+func copyWasmExec(dir string) error {
+	path, err := wasmExec()
+	if err != nil {
+		return err
+	}
+
+	return files.CopyFile(path, filepath.Join(dir, "wasm_exec.js"))
+}
+
+// buildWasm builds the app package in dir to the output
+// file, the error has the compiler output
+func buildWasm(dir, output string) error {
+	output, err := filepath.Abs(output)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "build", "-o", output, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("go build: %w\n%s", err, out)
+	}
+
+	return nil
+}
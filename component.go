@@ -0,0 +1,143 @@
+package gtml
+
+import "github.com/4lxprime/gtml/elements"
+
+// Ctx is the instance of a component, it keeps the component states
+// (see UseState, Computed and Effect, a Ctx is a Scope) between
+// renders and its lifecycle hooks
+//
+// NOTE: like every hooks, states must be created in the
+// same order at each render (e.g. not in a condition)
+type Ctx struct {
+	app     *App
+	element *elements.DynamicEl
+	// observer renders the component again when
+	// a state read while rendering changes
+	observer  *observer
+	hooks     []interface{}
+	hookIndex int
+	disposers []func()
+	mounts    []func()
+	unmounts  []func()
+	updates   []func()
+}
+
+func (c *Ctx) stateManager() *StateManager { return c.app.StateManager }
+
+func (c *Ctx) hook(create func() interface{}) interface{} {
+	if c.hookIndex == len(c.hooks) {
+		c.hooks = append(c.hooks, create())
+	}
+
+	value := c.hooks[c.hookIndex]
+	c.hookIndex++
+
+	return value
+}
+
+func (c *Ctx) own(dispose func()) { c.disposers = append(c.disposers, dispose) }
+
+// App returns the app in which the component is rendered
+func (c *Ctx) App() *App { return c.app }
+
+// OnMount registers fn to be called once the component is in the dom
+func (c *Ctx) OnMount(fn func()) { c.mounts = append(c.mounts, fn) }
+
+// OnUnmount registers fn to be called when the component is removed
+func (c *Ctx) OnUnmount(fn func()) { c.unmounts = append(c.unmounts, fn) }
+
+// OnUpdate registers fn to be called each time the component
+// is rendered again and its dom is patched
+func (c *Ctx) OnUpdate(fn func()) { c.updates = append(c.updates, fn) }
+
+// Refresh renders the component again
+func (c *Ctx) Refresh() { elements.Update(c.element) }
+
+func (c *Ctx) mount() {
+	for _, fn := range c.mounts {
+		fn()
+	}
+}
+
+func (c *Ctx) update() {
+	for _, fn := range c.updates {
+		fn()
+	}
+}
+
+func (c *Ctx) unmount() {
+	c.observer.dispose()
+
+	for _, fn := range c.unmounts {
+		fn()
+	}
+
+	for _, dispose := range c.disposers {
+		dispose()
+	}
+}
+
+// Component returns a constructor of elements rendered by fn with the
+// given props, each element is an instance of the component with its
+// own Ctx, it's rendered again when a state it read changes and when
+// its parent is rendered again, the instance is kept
+//
+// example:
+//
+//	Counter := gtml.Component(app, func(ctx *gtml.Ctx, label string) Element {
+//		count := gtml.UseState(ctx, 0)
+//
+//		return Button(
+//			OnClick(func() { count.Update(func(c int) int { return c + 1 }) }),
+//		)(
+//			Textf("%s: %d", label, count.Get()),
+//		)
+//	})
+//
+//	Div()(Counter("first"), Counter("second"))
+func Component[P any](
+	app *App,
+	fn func(ctx *Ctx, props P) elements.Element,
+) func(props P) elements.Element {
+	// identifies the component elements, so an element
+	// replaced by another one of the component keeps its ctx
+	component := new(struct{ byte })
+
+	return func(props P) elements.Element {
+		dynamic := &elements.DynamicEl{Component: component}
+
+		ctx := func() *Ctx {
+			if dynamic.Instance == nil {
+				c := &Ctx{app: app}
+				c.observer = app.StateManager.newObserver(func() {
+					elements.Update(c.element)
+				})
+
+				dynamic.Instance = c
+			}
+
+			c := dynamic.Instance.(*Ctx)
+			c.element = dynamic
+
+			return c
+		}
+
+		dynamic.Render = func() elements.Element {
+			c := ctx()
+
+			// lifecycle hooks are registered again at each render
+			c.hookIndex = 0
+			c.mounts, c.unmounts, c.updates = nil, nil, nil
+
+			var el elements.Element
+			c.observer.track(func() { el = fn(c, props) })
+
+			return el
+		}
+		dynamic.Mounted = func() { ctx().mount() }
+		dynamic.Updated = func() { ctx().update() }
+		dynamic.Dispose = func() { ctx().unmount() }
+
+		return dynamic
+	}
+}
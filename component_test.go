@@ -0,0 +1,95 @@
+package gtml
+
+import (
+	"testing"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+func TestComponentLifecycle(t *testing.T) {
+	app := NewApp()
+	show := UseState(app, true)
+	label := UseState(app, "a")
+
+	var events []string
+	Child := Component(app, func(ctx *Ctx, label string) elements.Element {
+		count := UseState(ctx, 0)
+
+		ctx.OnMount(func() { events = append(events, "mount") })
+		ctx.OnUpdate(func() { events = append(events, "update") })
+		ctx.OnUnmount(func() { events = append(events, "unmount") })
+
+		return elements.Button(elements.OnClick(func() {
+			count.Update(func(c int) int { return c + 1 })
+		}))(elements.Textf("%s%d", label, count.Get()))
+	})
+
+	var body *elements.MemoryNode
+	mountChild := func() {
+		body = mountApp(app, elements.Div()(app.Reactive(func() elements.Element {
+			if !show.Get() {
+				return elements.P()()
+			}
+			return Child(label.Get())
+		})))
+	}
+
+	button := func() *elements.MemoryNode { return body.Children[0].Children[0] }
+
+	tests := []struct {
+		name   string
+		do     func()
+		text   string
+		events []string
+	}{
+		{name: "mounted", do: mountChild, text: "a0", events: []string{"mount"}},
+		{name: "state", do: func() { button().Dispatch("click") }, text: "a1", events: []string{"update"}},
+		// the parent render gives new props, the instance and its state are kept
+		{name: "props", do: func() { label.Set("b") }, text: "b1", events: []string{"update"}},
+		{name: "unmounted", do: func() { show.Set(false) }, text: "", events: []string{"unmount"}},
+		// a new instance is created with a new state
+		{name: "mounted again", do: func() { show.Set(true) }, text: "b0", events: []string{"mount"}},
+	}
+
+	for _, test := range tests {
+		events = nil
+		test.do()
+
+		if got := text(body); got != test.text {
+			t.Errorf("%s: got text %q, want %q", test.name, got, test.text)
+		}
+
+		if len(events) != len(test.events) {
+			t.Errorf("%s: got events %v, want %v", test.name, events, test.events)
+			continue
+		}
+		for i := range events {
+			if events[i] != test.events[i] {
+				t.Errorf("%s: got events %v, want %v", test.name, events, test.events)
+				break
+			}
+		}
+	}
+}
+
+func TestComponentInstances(t *testing.T) {
+	app := NewApp()
+
+	Counter := Component(app, func(ctx *Ctx, _ struct{}) elements.Element {
+		count := UseState(ctx, 0)
+
+		return elements.Button(elements.OnClick(func() {
+			count.Update(func(c int) int { return c + 1 })
+		}))(elements.Textf("%d", count.Get()))
+	})
+
+	body := mountApp(app, elements.Div()(Counter(struct{}{}), Counter(struct{}{})))
+
+	div := body.Children[0]
+	div.Children[1].Dispatch("click")
+	div.Children[1].Dispatch("click")
+
+	if got := text(body); got != "02" {
+		t.Errorf("got %s, want 02", got)
+	}
+}
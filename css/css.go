@@ -0,0 +1,95 @@
+// Package css builds the css of the elements in go: typed properties
+// and values (units, colors and keywords) instead of raw strings, so
+// a typo or a missing semicolon doesn't silently break the style
+package css
+
+import (
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// Value is a css value (e.g. a Length, a ColorValue or a Keyword)
+type Value interface {
+	String() string
+}
+
+// Declaration is a css property and its value, see the
+// properties functions (e.g. Padding or Color)
+type Declaration struct {
+	Property string
+	Value    string
+	// watch changes the value in the dom, see Bind
+	watch func(set func(value string)) (stop func())
+}
+
+// String returns the declaration as css (e.g. "padding: 10px;")
+func (d Declaration) String() string {
+	return d.Property + ": " + d.Value + ";"
+}
+
+// Prop returns the declaration of any property, it's used for
+// the properties without a function and custom properties
+//
+// example:
+//
+//	css.Prop("--accent", css.Hex("#0af"))
+//	css.Prop("overflow-x", css.Auto)
+func Prop(property string, value Value) Declaration {
+	return Declaration{Property: property, Value: value.String()}
+}
+
+// Style returns the attribute setting the declarations, each property
+// is set with style.setProperty instead of replacing the style
+// attribute, so only the changed properties are set when the
+// element is rendered again
+//
+// NOTE: it can be given with the Style attribute, the
+// declarations are added to the style attribute
+//
+// example:
+//
+//	Div(css.Style(
+//		css.Display(css.Flex),
+//		css.Padding(css.Px(10), css.Rem(2)),
+//		css.Color(css.RGB(255, 0, 0)),
+//	))(...)
+func Style(declarations ...Declaration) elements.Attribute {
+	styles := make(elements.Styles, len(declarations))
+	for _, d := range declarations {
+		styles[d.Property] = elements.StyleValue{
+			Value: d.Value,
+			Watch: d.watch,
+		}
+	}
+
+	return elements.Attribute{
+		Name:  "Styles",
+		Value: styles,
+	}
+}
+
+// Bind returns the declaration of fn called with the state value,
+// the property follows the state: each time it's set, fn is called
+// again and only the property is changed in the dom, the element
+// isn't rendered again
+//
+// example:
+//
+//	width := gtml.UseState(app, 100)
+//
+//	Div(css.Style(
+//		css.Bind(width, func(width int) css.Declaration {
+//			return css.Width(css.Px(width))
+//		}),
+//	))(...)
+func Bind[T any](state *gtml.State[T], fn func(value T) Declaration) Declaration {
+	d := fn(state.Peek())
+
+	d.watch = func(set func(value string)) func() {
+		return state.Subscribe(func(value T) {
+			set(fn(value).Value)
+		})
+	}
+
+	return d
+}
@@ -0,0 +1,45 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+func TestBind(t *testing.T) {
+	app := gtml.NewApp()
+	width := gtml.UseState(app, 100)
+
+	el := elements.Div(Style(
+		Display(Block),
+		Bind(width, func(width int) Declaration {
+			return Width(Px(width))
+		}),
+	))()
+
+	d := elements.NewMemoryDOM()
+	elements.SetDOM(d)
+	elements.Mount(el, d.Body())
+
+	node := d.Body().(*elements.MemoryNode).Children[0]
+	if got := node.Styles["width"]; got != "100px" {
+		t.Errorf("got width %s, want 100px", got)
+	}
+
+	// the property follows the state without rendering the element
+	width.Set(50)
+	if got := node.Styles["width"]; got != "50px" {
+		t.Errorf("got width %s, want 50px", got)
+	}
+	if got := node.Styles["display"]; got != "block" {
+		t.Errorf("got display %s, want block", got)
+	}
+
+	// the state isn't watched anymore once the element is removed
+	elements.Unmount(el)
+	width.Set(20)
+	if got := node.Styles["width"]; got != "50px" {
+		t.Errorf("the removed node width is %s, want 50px", got)
+	}
+}
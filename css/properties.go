@@ -0,0 +1,131 @@
+package css
+
+import (
+	"strconv"
+	"strings"
+)
+
+func declaration(property string, value string) Declaration {
+	return Declaration{Property: property, Value: value}
+}
+
+// ---------------- Layout ----->
+
+func Display(v KeywordValue) Declaration        { return declaration("display", v.String()) }
+func Position(v KeywordValue) Declaration       { return declaration("position", v.String()) }
+func Top(v LengthValue) Declaration             { return declaration("top", v.String()) }
+func Right(v LengthValue) Declaration           { return declaration("right", v.String()) }
+func Bottom(v LengthValue) Declaration          { return declaration("bottom", v.String()) }
+func Left(v LengthValue) Declaration            { return declaration("left", v.String()) }
+func ZIndex(v int) Declaration                  { return declaration("z-index", strconv.Itoa(v)) }
+func Overflow(v KeywordValue) Declaration       { return declaration("overflow", v.String()) }
+func Visibility(v KeywordValue) Declaration     { return declaration("visibility", v.String()) }
+func BoxSizing(v KeywordValue) Declaration      { return declaration("box-sizing", v.String()) }
+func Width(v LengthValue) Declaration           { return declaration("width", v.String()) }
+func Height(v LengthValue) Declaration          { return declaration("height", v.String()) }
+func MinWidth(v LengthValue) Declaration        { return declaration("min-width", v.String()) }
+func MaxWidth(v LengthValue) Declaration        { return declaration("max-width", v.String()) }
+func MinHeight(v LengthValue) Declaration       { return declaration("min-height", v.String()) }
+func MaxHeight(v LengthValue) Declaration       { return declaration("max-height", v.String()) }
+func FlexDirection(v KeywordValue) Declaration  { return declaration("flex-direction", v.String()) }
+func FlexWrap(v KeywordValue) Declaration       { return declaration("flex-wrap", v.String()) }
+func FlexBasis(v LengthValue) Declaration       { return declaration("flex-basis", v.String()) }
+func JustifyContent(v KeywordValue) Declaration { return declaration("justify-content", v.String()) }
+func AlignItems(v KeywordValue) Declaration     { return declaration("align-items", v.String()) }
+func AlignSelf(v KeywordValue) Declaration      { return declaration("align-self", v.String()) }
+
+func FlexGrow[N Number](v N) Declaration   { return declaration("flex-grow", formatNumber(v)) }
+func FlexShrink[N Number](v N) Declaration { return declaration("flex-shrink", formatNumber(v)) }
+
+// GridTemplateColumns sets the grid columns sizes
+//
+// example:
+//
+//	css.GridTemplateColumns(css.Fr(1), css.Px(200)) // 1fr 200px
+func GridTemplateColumns(v ...LengthValue) Declaration {
+	return declaration("grid-template-columns", joinLengths(v))
+}
+
+func GridTemplateRows(v ...LengthValue) Declaration {
+	return declaration("grid-template-rows", joinLengths(v))
+}
+
+// ---------------- Spacing ----->
+
+// Margin takes from one to four lengths like the css shorthand
+func Margin(v ...LengthValue) Declaration { return declaration("margin", joinLengths(v)) }
+
+func MarginTop(v LengthValue) Declaration    { return declaration("margin-top", v.String()) }
+func MarginRight(v LengthValue) Declaration  { return declaration("margin-right", v.String()) }
+func MarginBottom(v LengthValue) Declaration { return declaration("margin-bottom", v.String()) }
+func MarginLeft(v LengthValue) Declaration   { return declaration("margin-left", v.String()) }
+
+// Padding takes from one to four lengths like the css shorthand
+func Padding(v ...LengthValue) Declaration { return declaration("padding", joinLengths(v)) }
+
+func PaddingTop(v LengthValue) Declaration    { return declaration("padding-top", v.String()) }
+func PaddingRight(v LengthValue) Declaration  { return declaration("padding-right", v.String()) }
+func PaddingBottom(v LengthValue) Declaration { return declaration("padding-bottom", v.String()) }
+func PaddingLeft(v LengthValue) Declaration   { return declaration("padding-left", v.String()) }
+
+// Gap takes the row gap and optionally the column gap
+func Gap(v ...LengthValue) Declaration { return declaration("gap", joinLengths(v)) }
+
+// ---------------- Colors and borders ----->
+
+func Color(v ColorValue) Declaration           { return declaration("color", v.value) }
+func BackgroundColor(v ColorValue) Declaration { return declaration("background-color", v.value) }
+func Opacity(v float64) Declaration            { return declaration("opacity", formatNumber(v)) }
+
+// Border sets the width, style and color of every borders
+//
+// example:
+//
+//	css.Border(css.Px(1), css.Solid, css.Hex("#ccc"))
+func Border(width LengthValue, style KeywordValue, color ColorValue) Declaration {
+	return declaration("border", width.String()+" "+style.String()+" "+color.value)
+}
+
+func BorderWidth(v ...LengthValue) Declaration  { return declaration("border-width", joinLengths(v)) }
+func BorderStyle(v KeywordValue) Declaration    { return declaration("border-style", v.String()) }
+func BorderColor(v ColorValue) Declaration      { return declaration("border-color", v.value) }
+func BorderRadius(v ...LengthValue) Declaration { return declaration("border-radius", joinLengths(v)) }
+
+func Outline(width LengthValue, style KeywordValue, color ColorValue) Declaration {
+	return declaration("outline", width.String()+" "+style.String()+" "+color.value)
+}
+
+// ---------------- Text ----->
+
+func FontSize(v LengthValue) Declaration        { return declaration("font-size", v.String()) }
+func FontWeight(v int) Declaration              { return declaration("font-weight", strconv.Itoa(v)) }
+func TextAlign(v KeywordValue) Declaration      { return declaration("text-align", v.String()) }
+func TextDecoration(v KeywordValue) Declaration { return declaration("text-decoration", v.String()) }
+func LineHeight[N Number](v N) Declaration      { return declaration("line-height", formatNumber(v)) }
+
+// FontFamily takes the families by order of preference,
+// the names with spaces are quoted
+func FontFamily(families ...string) Declaration {
+	values := make([]string, len(families))
+	for i, family := range families {
+		values[i] = family
+		if strings.ContainsAny(family, " ") {
+			values[i] = strconv.Quote(family)
+		}
+	}
+
+	return declaration("font-family", strings.Join(values, ", "))
+}
+
+// ---------------- Interaction ----->
+
+func Cursor(v KeywordValue) Declaration { return declaration("cursor", v.String()) }
+
+// Transition animates the property changes
+//
+// example:
+//
+//	css.Transition("opacity", css.Ms(200), css.EaseOut)
+func Transition(property string, duration Duration, timing KeywordValue) Declaration {
+	return declaration("transition", property+" "+duration.value+" "+timing.String())
+}
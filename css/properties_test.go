@@ -0,0 +1,43 @@
+package css
+
+import "testing"
+
+func TestProperties(t *testing.T) {
+	tests := []struct {
+		declaration Declaration
+		want        string
+	}{
+		// auto is a length and a keyword
+		{declaration: Width(Auto), want: "width: auto;"},
+		{declaration: Margin(Zero, Auto), want: "margin: 0 auto;"},
+		{declaration: Overflow(Auto), want: "overflow: auto;"},
+		{declaration: Cursor(Auto), want: "cursor: auto;"},
+
+		{declaration: Display(Flex), want: "display: flex;"},
+		{declaration: Height(Calc("100vh - 4rem")), want: "height: calc(100vh - 4rem);"},
+		{declaration: Margin(Px(1)), want: "margin: 1px;"},
+		{declaration: Padding(Px(1), Em(2), Percent(3), Rem(4)), want: "padding: 1px 2em 3% 4rem;"},
+		{declaration: Gap(Rem(1), Rem(2)), want: "gap: 1rem 2rem;"},
+		{declaration: GridTemplateColumns(Fr(1), Px(200)), want: "grid-template-columns: 1fr 200px;"},
+		{declaration: ZIndex(-1), want: "z-index: -1;"},
+		{declaration: FlexGrow(1), want: "flex-grow: 1;"},
+		{declaration: FlexShrink(0.5), want: "flex-shrink: 0.5;"},
+		{declaration: LineHeight(1.4), want: "line-height: 1.4;"},
+		{declaration: Opacity(0.75), want: "opacity: 0.75;"},
+		{declaration: FontWeight(600), want: "font-weight: 600;"},
+		{declaration: Color(RGB(255, 0, 0)), want: "color: rgb(255, 0, 0);"},
+		{declaration: BackgroundColor(Hex("fff")), want: "background-color: #fff;"},
+		{declaration: Border(Px(1), Solid, Hex("#ccc")), want: "border: 1px solid #ccc;"},
+		{declaration: Outline(Px(2), Dashed, CurrentColor), want: "outline: 2px dashed currentColor;"},
+		{declaration: BorderRadius(Px(4), Percent(50)), want: "border-radius: 4px 50%;"},
+		{declaration: FontFamily("Open Sans", "sans-serif"), want: `font-family: "Open Sans", sans-serif;`},
+		{declaration: Transition("opacity", Ms(200), EaseOut), want: "transition: opacity 200ms ease-out;"},
+		{declaration: Prop("--accent", Hex("#0af")), want: "--accent: #0af;"},
+	}
+
+	for _, test := range tests {
+		if got := test.declaration.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,403 @@
+package css
+
+import (
+	"hash/fnv"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/4lxprime/gtml/head"
+)
+
+// Item is a part of a stylesheet: a declaration, a class, a nested
+// rule, a media query or keyframes (see NewSheet)
+type Item interface {
+	isItem()
+}
+
+func (Declaration) isItem() {}
+
+// rule is a rule of a sheet, the selector of a class
+// rule is its name, scoped when the sheet is created
+type rule struct {
+	kind     ruleKind
+	selector string
+	items    []Item
+}
+
+type ruleKind int
+
+const (
+	classRule ruleKind = iota
+	selectorRule
+	mediaRule
+	keyframesRule
+)
+
+func (rule) isItem() {}
+
+// Class returns a rule of the class, its name is scoped by the sheet
+// (see Sheet.Class), nested in a rule it's a descendant of the rule
+func Class(name string, items ...Item) Item {
+	return rule{kind: classRule, selector: name, items: items}
+}
+
+// Rule returns a rule nested in its parent rule, "&" is the parent
+// selector (e.g. "&:hover" or "& > li"), without it the selector is a
+// descendant of the parent, the classes of the sheet can be used in
+// the selector (e.g. ".card .title") and are scoped like the others
+func Rule(selector string, items ...Item) Item {
+	return rule{kind: selectorRule, selector: selector, items: items}
+}
+
+func Hover(items ...Item) Item       { return Rule("&:hover", items...) }
+func Focus(items ...Item) Item       { return Rule("&:focus", items...) }
+func Active(items ...Item) Item      { return Rule("&:active", items...) }
+func Disabled(items ...Item) Item    { return Rule("&:disabled", items...) }
+func FirstChild(items ...Item) Item  { return Rule("&:first-child", items...) }
+func LastChild(items ...Item) Item   { return Rule("&:last-child", items...) }
+func Before(items ...Item) Item      { return Rule("&::before", items...) }
+func After(items ...Item) Item       { return Rule("&::after", items...) }
+func Placeholder(items ...Item) Item { return Rule("&::placeholder", items...) }
+
+// Media returns the items of the parent rule applied
+// only when the media query matches
+//
+// example:
+//
+//	css.Class("menu",
+//		css.Display(css.Flex),
+//		css.Media("(max-width: 600px)", css.Display(css.None)),
+//	)
+func Media(query string, items ...Item) Item {
+	return rule{kind: mediaRule, selector: query, items: items}
+}
+
+// Keyframes returns an animation of the sheet, its
+// name is scoped by the sheet (see Sheet.Animation)
+//
+// example:
+//
+//	css.Keyframes("fade",
+//		css.Frame("from", css.Opacity(0)),
+//		css.Frame("to", css.Opacity(1)),
+//	)
+func Keyframes(name string, frames ...Item) Item {
+	return rule{kind: keyframesRule, selector: name, items: frames}
+}
+
+// Frame returns a frame of keyframes, the offset
+// is "from", "to" or a percentage (e.g. "50%")
+func Frame(offset string, declarations ...Declaration) Item {
+	items := make([]Item, len(declarations))
+	for i, d := range declarations {
+		items[i] = d
+	}
+
+	return Rule(offset, items...)
+}
+
+// Animation sets the animation of the keyframes, the keyframes
+// of the sheet can be given by their name, they are scoped
+func Animation(name string, duration Duration, timing KeywordValue, options ...string) Declaration {
+	value := strings.Join(append([]string{name, duration.value, timing.String()}, options...), " ")
+
+	return declaration("animation", value)
+}
+
+// Sheet is a stylesheet declared in go, its classes and keyframes
+// names are scoped (made unique with a hash of the css) so they
+// don't conflict with other sheets, the css is added in a style
+// tag of the document head once the sheet is used
+type Sheet struct {
+	key       string
+	classes   map[string]string
+	keyframes map[string]string
+	css       string
+}
+
+// NewSheet returns a stylesheet of the items, the scoped class names
+// are given by Class, using a name of the sheet adds its style tag to
+// the document head (with the head package, so it's also written in
+// the pages rendered on the server)
+//
+// NOTE: the declarations bound to a state (see Bind) are static in a sheet
+//
+// example:
+//
+//	var styles = css.NewSheet(
+//		css.Class("button",
+//			css.Padding(css.Px(8), css.Px(16)),
+//			css.BackgroundColor(css.Hex("#0af")),
+//			css.Hover(css.BackgroundColor(css.Hex("#08c"))),
+//			css.Media("(max-width: 600px)", css.Width(css.Percent(100))),
+//		),
+//		css.Keyframes("fade",
+//			css.Frame("from", css.Opacity(0)),
+//			css.Frame("to", css.Opacity(1)),
+//		),
+//		css.Class("modal", css.Animation("fade", css.Ms(200), css.EaseOut)),
+//	)
+//
+//	Button(Class(styles.Class("button")))(Text("Save"))
+func NewSheet(items ...Item) *Sheet {
+	s := &Sheet{
+		classes:   make(map[string]string),
+		keyframes: make(map[string]string),
+	}
+
+	// the css with the names as they are gives
+	// the hash used to scope them
+	names(items, s.classes, s.keyframes)
+
+	for name := range s.classes {
+		s.classes[name] = name
+	}
+	for name := range s.keyframes {
+		s.keyframes[name] = name
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(s.generate(items)))
+	s.key = strconv.FormatUint(uint64(hash.Sum32()), 36)
+
+	for name := range s.classes {
+		s.classes[name] = name + "-" + s.key
+	}
+	for name := range s.keyframes {
+		s.keyframes[name] = name + "-" + s.key
+	}
+
+	s.css = s.generate(items)
+
+	return s
+}
+
+// names collects the classes and keyframes names of the items
+func names(items []Item, classes, keyframes map[string]string) {
+	for _, item := range items {
+		r, ok := item.(rule)
+		if !ok {
+			continue
+		}
+
+		switch r.kind {
+		case classRule:
+			classes[r.selector] = ""
+		case keyframesRule:
+			keyframes[r.selector] = ""
+			continue
+		}
+
+		names(r.items, classes, keyframes)
+	}
+}
+
+// Class returns the scoped name of the class and adds
+// the sheet to the document head if it's not there yet
+func (s *Sheet) Class(name string) string {
+	scoped, ok := s.classes[name]
+	if !ok {
+		log.Printf("class %s is not in the sheet", name)
+		return name
+	}
+
+	s.Inject()
+
+	return scoped
+}
+
+// Classes returns the scoped names of the classes separated by spaces
+func (s *Sheet) Classes(names ...string) string {
+	classes := make([]string, len(names))
+	for i, name := range names {
+		classes[i] = s.Class(name)
+	}
+
+	return strings.Join(classes, " ")
+}
+
+// Animation returns the scoped name of the keyframes
+// (e.g. to be used in a Style attribute)
+func (s *Sheet) Animation(name string) string {
+	scoped, ok := s.keyframes[name]
+	if !ok {
+		log.Printf("keyframes %s are not in the sheet", name)
+		return name
+	}
+
+	s.Inject()
+
+	return scoped
+}
+
+// CSS returns the css of the sheet
+func (s *Sheet) CSS() string { return s.css }
+
+// Inject adds the style tag of the sheet to the document head, it's
+// done by Class and Animation, a sheet is only added once
+func (s *Sheet) Inject() {
+	head.Style("css:"+s.key, s.css)
+}
+
+// block is a rule with its declarations, the
+// nested rules are flattened in other blocks
+type block struct {
+	media        string
+	selector     string
+	declarations []string
+}
+
+// generate returns the css of the items
+func (s *Sheet) generate(items []Item) string {
+	var b strings.Builder
+
+	blocks := []block{}
+	keyframes := []rule{}
+	s.flatten(items, "", "", &blocks, &keyframes)
+
+	// consecutive blocks of the same media are in the same @media rule
+	media := ""
+	for _, bl := range blocks {
+		if bl.media != media {
+			if media != "" {
+				b.WriteString("}\n")
+			}
+			if bl.media != "" {
+				b.WriteString("@media " + bl.media + " {\n")
+			}
+			media = bl.media
+		}
+
+		b.WriteString(bl.selector + " { " + strings.Join(bl.declarations, " ") + " }\n")
+	}
+	if media != "" {
+		b.WriteString("}\n")
+	}
+
+	for _, k := range keyframes {
+		b.WriteString("@keyframes " + s.keyframes[k.selector] + " {\n")
+
+		for _, item := range k.items {
+			frame, ok := item.(rule)
+			if !ok {
+				continue
+			}
+
+			declarations := []string{}
+			for _, item := range frame.items {
+				if d, ok := item.(Declaration); ok {
+					declarations = append(declarations, s.declaration(d))
+				}
+			}
+
+			b.WriteString(frame.selector + " { " + strings.Join(declarations, " ") + " }\n")
+		}
+
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// flatten appends the block of the items declarations
+// under the selector and then the nested rules blocks
+func (s *Sheet) flatten(items []Item, selector, media string, blocks *[]block, keyframes *[]rule) {
+	current := block{media: media, selector: selector}
+	*blocks = append(*blocks, current)
+	index := len(*blocks) - 1
+
+	for _, item := range items {
+		switch item := item.(type) {
+		case Declaration:
+			if selector == "" {
+				log.Printf("css declaration %s is not in a rule", item.Property)
+				continue
+			}
+
+			(*blocks)[index].declarations = append((*blocks)[index].declarations, s.declaration(item))
+
+		case rule:
+			switch item.kind {
+			case classRule:
+				s.flatten(item.items, nest(selector, "."+s.classes[item.selector]), media, blocks, keyframes)
+
+			case selectorRule:
+				s.flatten(item.items, nest(selector, s.scope(item.selector)), media, blocks, keyframes)
+
+			case mediaRule:
+				query := item.selector
+				if media != "" {
+					query = media + " and " + query
+				}
+
+				s.flatten(item.items, selector, query, blocks, keyframes)
+
+			case keyframesRule:
+				*keyframes = append(*keyframes, item)
+			}
+		}
+	}
+
+	// the rules without declarations are not written
+	if len((*blocks)[index].declarations) == 0 {
+		*blocks = append((*blocks)[:index], (*blocks)[index+1:]...)
+	}
+}
+
+// declaration returns the css of the declaration, the
+// keyframes names of an animation are scoped
+func (s *Sheet) declaration(d Declaration) string {
+	if d.Property == "animation" || d.Property == "animation-name" {
+		words := strings.Fields(d.Value)
+		for i, word := range words {
+			if scoped, ok := s.keyframes[word]; ok {
+				words[i] = scoped
+			}
+		}
+
+		return declaration(d.Property, strings.Join(words, " ")).String()
+	}
+
+	return d.String()
+}
+
+var classPattern = regexp.MustCompile(`\.(-?[_a-zA-Z][_a-zA-Z0-9-]*)`)
+
+// scope replaces the classes of the sheet in the selector by their scoped names
+func (s *Sheet) scope(selector string) string {
+	return classPattern.ReplaceAllStringFunc(selector, func(class string) string {
+		if scoped, ok := s.classes[class[1:]]; ok {
+			return "." + scoped
+		}
+
+		return class
+	})
+}
+
+// nest returns the selector of a rule nested in the parent one,
+// "&" is replaced by the parent selector, every selectors of
+// the lists (e.g. "a, b") are combined
+func nest(parent, selector string) string {
+	if parent == "" {
+		return strings.ReplaceAll(selector, "&", "")
+	}
+
+	selectors := []string{}
+	for _, p := range strings.Split(parent, ",") {
+		p = strings.TrimSpace(p)
+
+		for _, child := range strings.Split(selector, ",") {
+			child = strings.TrimSpace(child)
+
+			if strings.Contains(child, "&") {
+				selectors = append(selectors, strings.ReplaceAll(child, "&", p))
+			} else {
+				selectors = append(selectors, p+" "+child)
+			}
+		}
+	}
+
+	return strings.Join(selectors, ", ")
+}
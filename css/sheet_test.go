@@ -0,0 +1,116 @@
+package css
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSheetCSS(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []Item
+		// want is the css, with {key} for the key of the sheet
+		want string
+	}{
+		{
+			name: "scoped classes",
+			items: []Item{
+				Class("card", Padding(Px(8)), Class("title", FontWeight(600))),
+				// the classes of the sheet are scoped, the others are kept
+				Rule(".card .title, .other", Color(Black)),
+			},
+			want: ".card-{key} { padding: 8px; }\n" +
+				".card-{key} .title-{key} { font-weight: 600; }\n" +
+				".card-{key} .title-{key}, .other { color: black; }\n",
+		},
+		{
+			name: "nesting over selectors lists",
+			items: []Item{
+				Rule("a, button",
+					Color(Black),
+					Rule("&:hover, &:focus", Color(White)),
+					Rule("span", Opacity(0.5)),
+				),
+			},
+			want: "a, button { color: black; }\n" +
+				"a:hover, a:focus, button:hover, button:focus { color: white; }\n" +
+				"a span, button span { opacity: 0.5; }\n",
+		},
+		{
+			name: "combined media queries",
+			items: []Item{
+				Class("menu",
+					Display(Flex),
+					Media("(min-width: 600px)",
+						Gap(Px(1)),
+						Media("(max-width: 900px)", Display(Block), Hover(Opacity(1))),
+					),
+				),
+			},
+			want: ".menu-{key} { display: flex; }\n" +
+				"@media (min-width: 600px) {\n" +
+				".menu-{key} { gap: 1px; }\n" +
+				"}\n" +
+				"@media (min-width: 600px) and (max-width: 900px) {\n" +
+				".menu-{key} { display: block; }\n" +
+				".menu-{key}:hover { opacity: 1; }\n" +
+				"}\n",
+		},
+		{
+			name: "renamed keyframes",
+			items: []Item{
+				Keyframes("fade", Frame("from", Opacity(0)), Frame("to", Opacity(1))),
+				Class("modal",
+					Animation("fade", Ms(200), EaseOut, "infinite"),
+					Prop("animation-name", Keyword{"fade"}),
+				),
+			},
+			want: ".modal-{key} { animation: fade-{key} 200ms ease-out infinite; animation-name: fade-{key}; }\n" +
+				"@keyframes fade-{key} {\n" +
+				"from { opacity: 0; }\n" +
+				"to { opacity: 1; }\n" +
+				"}\n",
+		},
+		{
+			name: "rules without declarations",
+			items: []Item{
+				Class("empty", Hover(Color(Black))),
+				Rule("p"),
+				Class("none", Media("print")),
+			},
+			want: ".empty-{key}:hover { color: black; }\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewSheet(test.items...)
+
+			if want := strings.ReplaceAll(test.want, "{key}", s.key); s.CSS() != want {
+				t.Errorf("got css:\n%s\nwant:\n%s", s.CSS(), want)
+			}
+		})
+	}
+}
+
+func TestSheetNames(t *testing.T) {
+	s := NewSheet(
+		Class("button", Padding(Px(8))),
+		Keyframes("spin", Frame("to", Opacity(1))),
+	)
+
+	if got, want := s.Classes("button", "missing"), "button-"+s.key+" missing"; got != want {
+		t.Errorf("got classes %s, want %s", got, want)
+	}
+	if got, want := s.Animation("spin"), "spin-"+s.key; got != want {
+		t.Errorf("got animation %s, want %s", got, want)
+	}
+
+	// the key is a hash of the css, other items give another key
+	if NewSheet(Class("button", Padding(Px(8)))).key != NewSheet(Class("button", Padding(Px(8)))).key {
+		t.Error("the same sheets have different keys")
+	}
+	if other := NewSheet(Class("button", Padding(Px(9)))); other.key == s.key {
+		t.Error("different sheets have the same key")
+	}
+}
@@ -0,0 +1,209 @@
+package css
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Number is a number that can be given to the units
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+func formatNumber[N Number](n N) string {
+	return strconv.FormatFloat(float64(n), 'f', -1, 64)
+}
+
+// ---------------- Lengths ----->
+
+// Length is a css length or percentage (e.g. Px(10) or Percent(50))
+type Length struct{ value string }
+
+func (l Length) String() string { return l.value }
+func (l Length) lengthValue()   {}
+
+// LengthValue is a value of the length properties (e.g. Width),
+// a Length or a LengthKeyword
+type LengthValue interface {
+	Value
+	lengthValue()
+}
+
+var Zero = Length{"0"}
+
+// LengthKeyword is a keyword that is a length too, so it can
+// be given to the length and the keyword properties
+//
+// example:
+//
+//	css.Width(css.Auto)
+//	css.Overflow(css.Auto)
+type LengthKeyword struct{ value string }
+
+func (k LengthKeyword) String() string { return k.value }
+func (k LengthKeyword) lengthValue()   {}
+func (k LengthKeyword) keywordValue()  {}
+
+var Auto = LengthKeyword{"auto"}
+
+func Px[N Number](n N) Length      { return Length{formatNumber(n) + "px"} }
+func Em[N Number](n N) Length      { return Length{formatNumber(n) + "em"} }
+func Rem[N Number](n N) Length     { return Length{formatNumber(n) + "rem"} }
+func Ch[N Number](n N) Length      { return Length{formatNumber(n) + "ch"} }
+func Vw[N Number](n N) Length      { return Length{formatNumber(n) + "vw"} }
+func Vh[N Number](n N) Length      { return Length{formatNumber(n) + "vh"} }
+func Fr[N Number](n N) Length      { return Length{formatNumber(n) + "fr"} }
+func Percent[N Number](n N) Length { return Length{formatNumber(n) + "%"} }
+
+// Calc returns a length computed by the browser
+//
+// example:
+//
+//	css.Calc("100% - 2rem") // calc(100% - 2rem)
+func Calc(expression string) Length { return Length{"calc(" + expression + ")"} }
+
+func joinLengths(lengths []LengthValue) string {
+	values := make([]string, len(lengths))
+	for i, length := range lengths {
+		values[i] = length.String()
+	}
+
+	return strings.Join(values, " ")
+}
+
+// ---------------- Durations ----->
+
+// Duration is a css time (e.g. Ms(200))
+type Duration struct{ value string }
+
+func (d Duration) String() string { return d.value }
+
+func Ms[N Number](n N) Duration      { return Duration{formatNumber(n) + "ms"} }
+func Seconds[N Number](n N) Duration { return Duration{formatNumber(n) + "s"} }
+
+// ---------------- Colors ----->
+
+// ColorValue is a css color (e.g. RGB(255, 0, 0) or Hex("#f00"))
+type ColorValue struct{ value string }
+
+func (c ColorValue) String() string { return c.value }
+
+var (
+	Transparent  = ColorValue{"transparent"}
+	CurrentColor = ColorValue{"currentColor"}
+	Black        = ColorValue{"black"}
+	White        = ColorValue{"white"}
+)
+
+func RGB(r, g, b uint8) ColorValue {
+	return ColorValue{fmt.Sprintf("rgb(%d, %d, %d)", r, g, b)}
+}
+
+// RGBA returns a color with an alpha between 0 and 1
+func RGBA(r, g, b uint8, a float64) ColorValue {
+	return ColorValue{fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, formatNumber(a))}
+}
+
+// HSL returns a color of the hue in degrees and
+// the saturation and lightness in percents
+func HSL(h, s, l float64) ColorValue {
+	return ColorValue{fmt.Sprintf("hsl(%s, %s%%, %s%%)", formatNumber(h), formatNumber(s), formatNumber(l))}
+}
+
+// Hex returns the color of an hexadecimal code, with or without "#"
+func Hex(code string) ColorValue {
+	return ColorValue{"#" + strings.TrimPrefix(code, "#")}
+}
+
+// ---------------- Keywords ----->
+
+// Keyword is a css keyword value (e.g. Flex or Center)
+type Keyword struct{ value string }
+
+func (k Keyword) String() string { return k.value }
+func (k Keyword) keywordValue()  {}
+
+// KeywordValue is a value of the keyword properties (e.g.
+// Overflow), a Keyword or a LengthKeyword
+type KeywordValue interface {
+	Value
+	keywordValue()
+}
+
+var (
+	None = Keyword{"none"}
+
+	// display
+	Block       = Keyword{"block"}
+	Inline      = Keyword{"inline"}
+	InlineBlock = Keyword{"inline-block"}
+	Flex        = Keyword{"flex"}
+	InlineFlex  = Keyword{"inline-flex"}
+	Grid        = Keyword{"grid"}
+	InlineGrid  = Keyword{"inline-grid"}
+	Contents    = Keyword{"contents"}
+
+	// position
+	Static   = Keyword{"static"}
+	Relative = Keyword{"relative"}
+	Absolute = Keyword{"absolute"}
+	Fixed    = Keyword{"fixed"}
+	Sticky   = Keyword{"sticky"}
+
+	// flex direction and wrap
+	Row           = Keyword{"row"}
+	RowReverse    = Keyword{"row-reverse"}
+	Column        = Keyword{"column"}
+	ColumnReverse = Keyword{"column-reverse"}
+	Wrap          = Keyword{"wrap"}
+	NoWrap        = Keyword{"nowrap"}
+
+	// alignment
+	Start        = Keyword{"start"}
+	End          = Keyword{"end"}
+	Center       = Keyword{"center"}
+	Justify      = Keyword{"justify"}
+	FlexStart    = Keyword{"flex-start"}
+	FlexEnd      = Keyword{"flex-end"}
+	SpaceBetween = Keyword{"space-between"}
+	SpaceAround  = Keyword{"space-around"}
+	SpaceEvenly  = Keyword{"space-evenly"}
+	Stretch      = Keyword{"stretch"}
+	Baseline     = Keyword{"baseline"}
+
+	// border style
+	Solid  = Keyword{"solid"}
+	Dashed = Keyword{"dashed"}
+	Dotted = Keyword{"dotted"}
+	Double = Keyword{"double"}
+
+	// overflow and visibility
+	Visible = Keyword{"visible"}
+	Hidden  = Keyword{"hidden"}
+	Scroll  = Keyword{"scroll"}
+	Clip    = Keyword{"clip"}
+
+	// cursor
+	Default    = Keyword{"default"}
+	Pointer    = Keyword{"pointer"}
+	NotAllowed = Keyword{"not-allowed"}
+	Grab       = Keyword{"grab"}
+
+	// text decoration
+	Underline   = Keyword{"underline"}
+	LineThrough = Keyword{"line-through"}
+
+	// box sizing
+	BorderBox  = Keyword{"border-box"}
+	ContentBox = Keyword{"content-box"}
+
+	// transition timing
+	Ease      = Keyword{"ease"}
+	EaseIn    = Keyword{"ease-in"}
+	EaseOut   = Keyword{"ease-out"}
+	EaseInOut = Keyword{"ease-in-out"}
+	Linear    = Keyword{"linear"}
+)
@@ -0,0 +1,43 @@
+package css
+
+import "testing"
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		value Value
+		want  string
+	}{
+		{value: Px(10), want: "10px"},
+		{value: Px(1.5), want: "1.5px"},
+		{value: Px(-2), want: "-2px"},
+		{value: Em(0.25), want: "0.25em"},
+		{value: Rem(2), want: "2rem"},
+		{value: Ch(60), want: "60ch"},
+		{value: Vw(100), want: "100vw"},
+		{value: Vh(50), want: "50vh"},
+		{value: Fr(1), want: "1fr"},
+		{value: Percent(33.5), want: "33.5%"},
+		{value: Px(uint8(255)), want: "255px"},
+		{value: Px(float32(0.5)), want: "0.5px"},
+		{value: Zero, want: "0"},
+		{value: Auto, want: "auto"},
+		{value: Calc("100% - 2rem"), want: "calc(100% - 2rem)"},
+		{value: Ms(200), want: "200ms"},
+		{value: Seconds(1.5), want: "1.5s"},
+		{value: RGB(255, 0, 10), want: "rgb(255, 0, 10)"},
+		{value: RGBA(0, 0, 0, 0.5), want: "rgba(0, 0, 0, 0.5)"},
+		{value: HSL(210, 50, 40.5), want: "hsl(210, 50%, 40.5%)"},
+		{value: Hex("#0af"), want: "#0af"},
+		{value: Hex("0af"), want: "#0af"},
+		{value: Transparent, want: "transparent"},
+		{value: CurrentColor, want: "currentColor"},
+		{value: InlineBlock, want: "inline-block"},
+		{value: SpaceBetween, want: "space-between"},
+	}
+
+	for _, test := range tests {
+		if got := test.value.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Package document generates the html page hosting a gtml app, it
+// owns the javascript contract between the page and runtime.Runtime
+package document
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/head"
+)
+
+// names of the javascript globals shared by the page and the runtime
+const (
+	// AppFunc is set by runtime.Runtime, the page calls it
+	// once the wasm app is running to build the app
+	AppFunc = "app"
+	// LoadedFunc is set by runtime.Runtime and called once the app is built
+	LoadedFunc = "loaded"
+	// StopFunc is set by runtime.Runtime, the page can call
+	// it to stop the app and remove it from the page
+	StopFunc = "stop"
+	// StateManagerStopFunc is set by runtime.Runtime,
+	// it stops the app states
+	StateManagerStopFunc = "stateManagerStop"
+	// DevGlobal is set by the "gtml dev" pages
+	DevGlobal = "gtmlDev"
+	// SaveStateFunc is set by the runtime in dev mode, the "gtml dev"
+	// pages call it before reloading to keep the states
+	SaveStateFunc = "gtmlSaveState"
+)
+
+// Meta is a meta tag, either Name or Property (e.g.
+// Open Graph tags) is set with its Content
+type Meta struct {
+	Name     string
+	Property string
+	Content  string
+}
+
+// Head describes the page head
+type Head struct {
+	Title       string
+	Meta        []Meta
+	Stylesheets []string
+	Scripts     []string
+	// Tags are set with the head package (e.g. head.Title), the
+	// ones set while Body is rendered and the style tags (e.g.
+	// the css sheets) are added by Render
+	Tags []head.Tag
+	// Raw is written as is at the end of the head
+	Raw string
+}
+
+// Document describes the page hosting the app
+//
+// example:
+//
+//	doc := document.New("My app")
+//	doc.Head.Stylesheets = []string{"/style.css"}
+//
+//	hash, err := document.HashFile("dist/main.wasm")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	doc.WasmHash = hash
+//
+//	doc.WriteFile("dist/index.html")
+type Document struct {
+	Lang string
+	Head Head
+	// Body is prerendered in the body (e.g. to be hydrated), it can be nil
+	Body elements.Element
+	// Wasm is the url of the wasm app, the page doesn't load
+	// any wasm if it's empty (e.g. static pages)
+	Wasm string
+	// WasmExec is the url of the go wasm_exec.js
+	WasmExec string
+	// WasmHash is added to the wasm url so the browsers
+	// download the app again when it changes, see HashFile
+	WasmHash string
+}
+
+// New returns a document loading /main.wasm and /wasm_exec.js
+func New(title string) *Document {
+	return &Document{
+		Lang:     "en",
+		Head:     Head{Title: title},
+		Wasm:     "/main.wasm",
+		WasmExec: "/wasm_exec.js",
+	}
+}
+
+// Render writes the html page into w
+func (d *Document) Render(w io.Writer) error {
+	var body bytes.Buffer
+	var err error
+
+	// the stylesheets are the same in every pages, the ones
+	// used outside of a capture (e.g. while Body was created)
+	// are written too
+	styles := []head.Tag{}
+	for _, tag := range head.Tags() {
+		if tag.Name == "style" {
+			styles = append(styles, tag)
+		}
+	}
+
+	// the components set their head tags while they are rendered
+	tags := head.Merge(styles, d.Head.Tags, head.Capture(func() {
+		if d.Body != nil {
+			err = elements.Render(d.Body, &body)
+		}
+	}))
+	if err != nil {
+		return err
+	}
+
+	title := d.Head.Title
+	for _, tag := range tags {
+		if tag.Name == "title" {
+			title = tag.Text
+		}
+	}
+
+	lang := d.Lang
+	if lang == "" {
+		lang = "en"
+	}
+
+	lines := []string{
+		`<meta charset="utf-8">`,
+		`<meta name="viewport" content="width=device-width, initial-scale=1">`,
+		"<title>" + html.EscapeString(title) + "</title>",
+	}
+
+	for _, meta := range d.Head.Meta {
+		if meta.Property != "" {
+			lines = append(lines, fmt.Sprintf(
+				`<meta property="%s" content="%s">`,
+				html.EscapeString(meta.Property), html.EscapeString(meta.Content),
+			))
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			`<meta name="%s" content="%s">`,
+			html.EscapeString(meta.Name), html.EscapeString(meta.Content),
+		))
+	}
+
+	for _, tag := range tags {
+		if tag.Name != "title" {
+			lines = append(lines, tag.HTML())
+		}
+	}
+
+	for _, href := range d.Head.Stylesheets {
+		lines = append(lines, fmt.Sprintf(`<link rel="stylesheet" href="%s">`, html.EscapeString(href)))
+	}
+
+	for _, src := range d.Head.Scripts {
+		lines = append(lines, fmt.Sprintf(`<script src="%s"></script>`, html.EscapeString(src)))
+	}
+
+	if d.Wasm != "" {
+		lines = append(lines, d.bootstrap())
+	}
+
+	if d.Head.Raw != "" {
+		lines = append(lines, d.Head.Raw)
+	}
+
+	_, err = fmt.Fprintf(
+		w,
+		"<!DOCTYPE html>\n<html lang=\"%s\">\n<head>\n%s\n</head>\n<body>%s</body>\n</html>\n",
+		html.EscapeString(lang),
+		strings.Join(lines, "\n"),
+		body.String(),
+	)
+
+	return err
+}
+
+// WriteFile writes the html page in the file at path
+func (d *Document) WriteFile(path string) error {
+	var buf bytes.Buffer
+	if err := d.Render(&buf); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// bootstrap returns the scripts running the wasm app, AppFunc is
+// called if the app uses runtime.Runtime instead of mounting itself
+func (d *Document) bootstrap() string {
+	wasm := d.Wasm
+	if d.WasmHash != "" {
+		separator := "?"
+		if strings.Contains(wasm, "?") {
+			separator = "&"
+		}
+
+		wasm += separator + "v=" + url.QueryEscape(d.WasmHash)
+	}
+
+	wasmExec := d.WasmExec
+	if wasmExec == "" {
+		wasmExec = "/wasm_exec.js"
+	}
+
+	return fmt.Sprintf(`<script src="%s"></script>
+<script>
+	const go = new Go();
+	WebAssembly.instantiateStreaming(fetch(%s), go.importObject).then((result) => {
+		go.run(result.instance);
+		if (typeof %s === "function") %s();
+	});
+</script>`,
+		html.EscapeString(wasmExec),
+		jsString(wasm),
+		AppFunc, AppFunc,
+	)
+}
+
+// jsString returns s quoted as a javascript string, "<" is escaped
+// so the string can't end the script (e.g. "</script>")
+func jsString(s string) string {
+	return strings.ReplaceAll(strconv.Quote(s), "<", `\u003c`)
+}
+
+// HashFile returns a short hash of the file content,
+// it's used as WasmHash to bust the browsers cache
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:12], nil
+}
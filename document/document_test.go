@@ -0,0 +1,182 @@
+package document
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/head"
+)
+
+func TestJSString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "/main.wasm", want: `"/main.wasm"`},
+		{s: `a"b\c`, want: `"a\"b\\c"`},
+		// the string can't end the script
+		{s: "</script><script>alert(1)", want: `"\u003c/script>\u003cscript>alert(1)"`},
+		{s: "a\nb", want: `"a\nb"`},
+	}
+
+	for _, test := range tests {
+		if got := jsString(test.s); got != test.want {
+			t.Errorf("jsString(%q) = %s, want %s", test.s, got, test.want)
+		}
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		wasm     string
+		hash     string
+		wasmExec string
+		want     []string
+	}{
+		{
+			name: "default",
+			wasm: "/main.wasm",
+			want: []string{`<script src="/wasm_exec.js"></script>`, `fetch("/main.wasm")`},
+		},
+		{
+			name:     "hash",
+			wasm:     "/main.wasm",
+			hash:     "abc123",
+			wasmExec: "/js/wasm_exec.js",
+			want:     []string{`<script src="/js/wasm_exec.js"></script>`, `fetch("/main.wasm?v=abc123")`},
+		},
+		{
+			name: "hash after a query",
+			wasm: "/main.wasm?app=1",
+			hash: "abc123",
+			want: []string{`fetch("/main.wasm?app=1&v=abc123")`},
+		},
+		{
+			name:     "escaped urls",
+			wasm:     `/</script>.wasm`,
+			wasmExec: `/a"b.js`,
+			want:     []string{`<script src="/a&#34;b.js"></script>`, `fetch("/\u003c/script>.wasm")`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &Document{Wasm: test.wasm, WasmHash: test.hash, WasmExec: test.wasmExec}
+			got := d.bootstrap()
+
+			for _, want := range test.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("the bootstrap doesn't contain %s:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+// render returns the page of the document or fails the test
+func render(t *testing.T, d *Document) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := d.Render(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	return b.String()
+}
+
+func TestRender(t *testing.T) {
+	d := New("a <title>")
+	d.Lang = "fr"
+	d.Head.Meta = []Meta{
+		{Name: "description", Content: `"quoted"`},
+		{Property: "og:title", Content: "title"},
+	}
+	d.Head.Stylesheets = []string{"/style.css"}
+	d.Head.Scripts = []string{"/script.js"}
+	d.Head.Raw = `<link rel="icon" href="/icon.png">`
+	d.Body = elements.Div(elements.ID("app"))(elements.Text("app"))
+
+	page := render(t, d)
+
+	for _, want := range []string{
+		"<!DOCTYPE html>\n<html lang=\"fr\">",
+		"<title>a &lt;title&gt;</title>",
+		`<meta name="description" content="&#34;quoted&#34;">`,
+		`<meta property="og:title" content="title">`,
+		`<link rel="stylesheet" href="/style.css">`,
+		`<script src="/script.js"></script>`,
+		`<link rel="icon" href="/icon.png">`,
+		`fetch("/main.wasm")`,
+		`<body><div id="app">app</div></body>`,
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("the page doesn't contain %s:\n%s", want, page)
+		}
+	}
+}
+
+func TestRenderWithoutWasm(t *testing.T) {
+	d := New("static")
+	d.Wasm = ""
+
+	if page := render(t, d); strings.Contains(page, "wasm") {
+		t.Errorf("the static page loads wasm:\n%s", page)
+	}
+}
+
+func TestRenderHeadTags(t *testing.T) {
+	d := New("default")
+	d.Head.Tags = []head.Tag{{Key: "meta:name:author", Name: "meta", Attributes: []head.Attr{
+		{Name: "name", Value: "author"},
+		{Name: "content", Value: "me"},
+	}}}
+
+	// the tags set while the body is rendered replace the document ones
+	d.Body = elements.Dynamic(func() elements.Element {
+		head.Title("from the body")
+		return elements.P()()
+	})
+
+	page := render(t, d)
+
+	for _, want := range []string{"<title>from the body</title>", `<meta name="author" content="me"`} {
+		if !strings.Contains(page, want) {
+			t.Errorf("the page doesn't contain %s:\n%s", want, page)
+		}
+	}
+	if strings.Contains(page, "<title>default</title>") {
+		t.Errorf("the default title is kept:\n%s", page)
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	dir := t.TempDir()
+
+	hash := func(content string) string {
+		path := filepath.Join(dir, "main.wasm")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		h, err := HashFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return h
+	}
+
+	a, b := hash("a"), hash("b")
+	if len(a) != 12 || a == b || a != hash("a") {
+		t.Errorf("got hashes %s, %s, want 12 chars hashes that differ by content", a, b)
+	}
+
+	if _, err := HashFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("no error for a missing file")
+	}
+}
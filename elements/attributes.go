@@ -1,6 +1,9 @@
 package elements
 
-import "fmt"
+import (
+	"fmt"
+	"log"
+)
 
 type Attribute struct {
 	Name  string
@@ -59,6 +62,11 @@ var (
 		Value: true,
 	}
 
+	NoValidate = Attribute{
+		Name:  "NoValidate",
+		Value: true,
+	}
+
 	Open = Attribute{
 		Name:  "Open",
 		Value: true,
@@ -311,6 +319,29 @@ func ID(value string) Attribute {
 	}
 }
 
+// identifies the element among its siblings, so when a list is
+// updated the nodes are moved instead of being rebuilt and they
+// keep their dom state (e.g. focus, checkbox state, input value)
+//
+// example:
+//
+//	Li(Key(user.ID))(Text(user.Name))
+//
+// NOTE: the key must be comparable and is not rendered in the DOM
+func Key(value any) Attribute {
+	return Attribute{
+		Name:  "Key",
+		Value: value,
+	}
+}
+
+// SetKey sets the key of an already created element, see Key
+func SetKey(el Element, key any) {
+	if err := setFieldValue(el, "Key", key); err != nil {
+		log.Println(err)
+	}
+}
+
 // define the language of an element
 //
 // example:
@@ -692,103 +723,120 @@ func EncType(value string) Attribute {
 
 // ---------------- Event Handlers ----->
 
+// EventHandler is an event handler without the event, every
+// On* attribute also takes a handler receiving the typed event
+//
+// example:
+//
+//	OnKeyDown(func(e KeyboardEvent) {
+//		if e.Key() == "Enter" {
+//			e.PreventDefault()
+//		}
+//	})
 type EventHandler func()
 
-func OnClick(handler EventHandler) Attribute {
+func OnClick[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnClick",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnDblClick(handler EventHandler) Attribute {
+func OnDblClick[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnDblClick",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnMouseDown(handler EventHandler) Attribute {
+func OnMouseDown[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnMouseDown",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnMouseUp(handler EventHandler) Attribute {
+func OnMouseUp[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnMouseUp",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnMouseMove(handler EventHandler) Attribute {
+func OnMouseMove[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnMouseMove",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnMouseOut(handler EventHandler) Attribute {
+func OnMouseOut[H MouseHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnMouseOut",
-		Value: handler,
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnMouseOver(handler EventHandler) Attribute {
+func OnMouseOver[H MouseHandler](handler H) Attribute {
 	return Attribute{
-		Name:  "OnMouseOut",
-		Value: handler,
+		Name:  "OnMouseOver",
+		Value: toListener(handler, mouseEvent),
 	}
 }
 
-func OnKeyDown(handler EventHandler) Attribute {
+func OnKeyDown[H KeyboardHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnKeyDown",
-		Value: handler,
+		Value: toListener(handler, keyboardEvent),
 	}
 }
 
-func OnKeyUp(handler EventHandler) Attribute {
+func OnKeyUp[H KeyboardHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnKeyUp",
-		Value: handler,
+		Value: toListener(handler, keyboardEvent),
 	}
 }
 
-func OnFocus(handler EventHandler) Attribute {
+func OnFocus[H Handler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnFocus",
-		Value: handler,
+		Value: toListener(handler, plainEvent),
 	}
 }
 
-func OnBlur(handler EventHandler) Attribute {
+func OnBlur[H Handler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnBlur",
-		Value: handler,
+		Value: toListener(handler, plainEvent),
+	}
+}
+
+func OnInput[H InputHandler](handler H) Attribute {
+	return Attribute{
+		Name:  "OnInput",
+		Value: toListener(handler, inputEvent),
 	}
 }
 
-func OnChange(handler EventHandler) Attribute {
+func OnChange[H InputHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnChange",
-		Value: handler,
+		Value: toListener(handler, inputEvent),
 	}
 }
 
-func OnSubmit(handler EventHandler) Attribute {
+func OnSubmit[H SubmitHandler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnSubmit",
-		Value: handler,
+		Value: toListener(handler, submitEvent),
 	}
 }
 
-func OnReset(handler EventHandler) Attribute {
+func OnReset[H Handler](handler H) Attribute {
 	return Attribute{
 		Name:  "OnReset",
-		Value: handler,
+		Value: toListener(handler, plainEvent),
 	}
 }
 
@@ -0,0 +1,58 @@
+package elements
+
+// Node is a node created by a DOM backend, its concrete type
+// depends on the backend (e.g. js.Value for the browser or
+// *MemoryNode for the in memory backend)
+type Node interface{}
+
+// DOM interface represents the backend used to build and update
+// elements, so the elements package doesn't depend on syscall/js
+// and views can be compiled and tested natively
+type DOM interface {
+	// Body returns the node in which the app is built
+	Body() Node
+	// QuerySelector returns the first node matching the css
+	// selector, or nil if there is none
+	QuerySelector(selector string) Node
+	CreateElement(tag string) Node
+	CreateTextNode(text string) Node
+	CreateComment(text string) Node
+	// SetNodeValue changes the text of a text node
+	SetNodeValue(node Node, text string)
+	SetAttribute(node Node, name, value string)
+	RemoveAttribute(node Node, name string)
+	// GetProperty returns the dom property (e.g. "value" as a string
+	// or "checked" as a bool), SelectedValues gives the values of
+	// the selected options of a select as a []string
+	GetProperty(node Node, name string) interface{}
+	SetProperty(node Node, name string, value interface{})
+	// SetStyleProperty and RemoveStyleProperty change a single
+	// css property of the node (e.g. "padding") like style.setProperty
+	SetStyleProperty(node Node, name, value string)
+	RemoveStyleProperty(node Node, name string)
+	AppendChild(parent, child Node)
+	// InsertBefore inserts child before ref in parent, if ref
+	// is nil the child is appended at the end of parent
+	InsertBefore(parent, child, ref Node)
+	RemoveChild(parent, child Node)
+	ChildNodes(node Node) []Node
+	// NodeName returns the lower cased tag of an element node,
+	// "#text" for a text node and "#comment" for a comment
+	NodeName(node Node) string
+	// AddEventListener returns a function that removes the
+	// listener and releases what the backend allocated for it
+	AddEventListener(node Node, event string, handler func(RawEvent)) (remove func())
+	// SetText replaces the whole node content by the given text, for
+	// a textarea it's the value, even once the user typed in it
+	SetText(node Node, text string)
+}
+
+// the backend used by Build and Update, the default one is the
+// syscall/js backend in the browser and the in memory one elsewhere
+var dom DOM = defaultDOM()
+
+// SetDOM changes the backend used to build and update elements
+func SetDOM(d DOM) { dom = d }
+
+// GetDOM returns the backend used to build and update elements
+func GetDOM() DOM { return dom }
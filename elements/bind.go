@@ -0,0 +1,96 @@
+package elements
+
+import "reflect"
+
+// SelectedValues is the property of the values of the selected
+// options of a select, it's a []string
+const SelectedValues = "selectedValues"
+
+// Binding keeps a dom property in sync with a value, the property is
+// set when the element is built or patched and Set is called with the
+// new property value when Event happens (see the gtml Bind* attributes)
+type Binding struct {
+	// Property is the dom property (e.g. "value", "checked" or SelectedValues)
+	Property string
+	// Event is the event after which the property is read (e.g. "input")
+	Event string
+	// Value is a string for "value", a bool for
+	// "checked" and a []string for SelectedValues
+	Value interface{}
+	Set   func(value interface{})
+	// Watch is called once the property is set with a function changing
+	// it (e.g. when the bound state changes), it returns a function
+	// stopping the watch, called when the element is patched or removed
+	//
+	// NOTE: set only changes the dom node, it can be called anytime
+	Watch func(set func(value interface{})) (stop func())
+}
+
+// elementBinding returns the binding of the element, if any
+func elementBinding(elem Element) *Binding {
+	field := reflect.ValueOf(elem).Elem().FieldByName("Bind")
+	if !field.IsValid() {
+		return nil
+	}
+
+	binding, _ := field.Interface().(*Binding)
+	return binding
+}
+
+// buildElementBinding sets the bound property and listens to the
+// binding event, it's called once the childs are built so a select
+// value can be set (its options must be there)
+func buildElementBinding(v *vnode, elem Element) {
+	binding := elementBinding(elem)
+
+	// the binding listener is kept while the event is the same
+	if v.binding != nil && (binding == nil || binding.Event != v.binding.Event) {
+		v.removeBinding()
+		v.removeBinding = nil
+	}
+
+	stopBindingWatch(v)
+
+	v.binding = binding
+	if binding == nil {
+		return
+	}
+
+	setBindingProperty(v.node, binding.Property, binding.Value)
+
+	if binding.Watch != nil {
+		node, property := v.node, binding.Property
+		v.stopBinding = binding.Watch(func(value interface{}) {
+			setBindingProperty(node, property, value)
+		})
+	}
+
+	if v.removeBinding != nil {
+		return
+	}
+
+	v.removeBinding = dom.AddEventListener(v.node, binding.Event, func(RawEvent) {
+		vnodesMutex.Lock()
+		binding := v.binding
+		vnodesMutex.Unlock()
+
+		if binding != nil && binding.Set != nil {
+			binding.Set(dom.GetProperty(v.node, binding.Property))
+		}
+	})
+}
+
+// setBindingProperty sets the property only if it changed,
+// so the caret of the input isn't moved while typing
+func setBindingProperty(node Node, property string, value interface{}) {
+	if !reflect.DeepEqual(dom.GetProperty(node, property), value) {
+		dom.SetProperty(node, property, value)
+	}
+}
+
+func stopBindingWatch(v *vnode) {
+	if v.stopBinding != nil {
+		v.stopBinding()
+		v.stopBinding = nil
+	}
+}
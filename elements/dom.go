@@ -3,8 +3,8 @@ package elements
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
-	"syscall/js"
 )
 
 // Element interface represents our custom element and is a
@@ -16,7 +16,10 @@ type Element interface {
 	GetChilds() []Element
 	AppendChild(Element)
 	GetElName() string
-	GetElValue() js.Value
+	GetElValue() Node
+	// GetKey returns the key used to identify the element
+	// among its siblings when a list is updated
+	GetKey() interface{}
 	// and so every dom element property
 	DOMElement
 }
@@ -47,6 +50,8 @@ type DOMElement interface {
 // NOTE: this will implement the DOMElement interface
 type BasicElement struct {
 	AccessKey       string
+	Aria            map[string]interface{} // aria-*
+	Bind            *Binding               // dom property binding, see Binding
 	Class           string
 	ContentEditable string
 	ContextMenu     string
@@ -57,27 +62,30 @@ type BasicElement struct {
 	Hidden          bool
 	ID              string
 	InputMode       string
+	Key             interface{} // not rendered, see Key attribute
 	Lang            string
 	SpellCheck      string
 	Style           string
+	Styles          Styles // css properties, see Styles
 	TabIndex        int64
 	Title           string
 	// event handlers:
 
-	OnClick     EventHandler
-	OnDblClick  EventHandler
-	OnMouseDown EventHandler
-	OnMouseUp   EventHandler
-	OnMouseMove EventHandler
-	OnMouseOver EventHandler
-	OnMouseOut  EventHandler
-	OnKeyDown   EventHandler
-	OnKeyUp     EventHandler
-	OnFocus     EventHandler
-	OnBlur      EventHandler
-	OnChange    EventHandler
-	OnSubmit    EventHandler
-	OnReset     EventHandler
+	OnClick     EventListener
+	OnDblClick  EventListener
+	OnMouseDown EventListener
+	OnMouseUp   EventListener
+	OnMouseMove EventListener
+	OnMouseOver EventListener
+	OnMouseOut  EventListener
+	OnKeyDown   EventListener
+	OnKeyUp     EventListener
+	OnFocus     EventListener
+	OnBlur      EventListener
+	OnInput     EventListener
+	OnChange    EventListener
+	OnSubmit    EventListener
+	OnReset     EventListener
 }
 
 func (e BasicElement) GetAccessKey() string       { return e.AccessKey }
@@ -90,128 +98,282 @@ func (e BasicElement) GetDropZone() string        { return e.DropZone }
 func (e BasicElement) GetHidden() bool            { return e.Hidden }
 func (e BasicElement) GetID() string              { return e.ID }
 func (e BasicElement) GetInputMode() string       { return e.InputMode }
+func (e BasicElement) GetKey() interface{}        { return e.Key }
 func (e BasicElement) GetLang() string            { return e.Lang }
 func (e BasicElement) GetSpellCheck() string      { return e.SpellCheck }
 func (e BasicElement) GetStyle() string           { return e.Style }
 func (e BasicElement) GetTabIndex() int64         { return e.TabIndex }
 func (e BasicElement) GetTitle() string           { return e.Title }
 
-func Update(e Element) {
-	elValue := e.GetElValue()
-	// re-build element attributes
-	buildElementAttributes(e, elValue)
+// Mount builds the element tree with the current backend
+// and appends it to the given parent node
+func Mount(element Element, parent Node) Node {
+	defer runLifecycle()
 
-	// remove each child elems
-	for elValue.Get("firstChild").Truthy() {
-		elValue.Call("removeChild", elValue.Get("firstChild"))
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	v := buildElement(element, parent, nil)
+	if node := v.domNode(); node != nil {
+		return node
 	}
 
-	// recreate childs elems
-	for _, child := range e.GetChilds() {
-		buildElement(child, elValue)
+	return parent
+}
+
+// Query returns the node matching target, either a css selector
+// or an element id, and nil if there is none
+func Query(target string) Node {
+	if node := dom.QuerySelector(target); node != nil {
+		return node
 	}
+
+	return dom.QuerySelector("#" + target)
 }
 
-func buildElementAttributes(elem Element, jsElement js.Value) {
-	elementMap := fieldsToMap(elem)
+func buildElementAttributes(v *vnode, elem Element) {
+	attributes, events := elementAttributes(elem)
 
-	for attributeName, attributeValue := range elementMap {
-		// with this we can do a specific logic for events
-		switch attr := attributeValue.(type) {
-		case EventHandler: // event handler case
-			// event listener with custom action for the event handler
-			jsElement.Call(
-				"addEventListener",
-				strings.ToLower(
-					attributeName[2:], // after On (e.g. OnClick -> Click -> click)
-				),
-				js.FuncOf(func(this js.Value, vals []js.Value) any {
-					attr()
-					return nil
-				}),
-			)
+	newAttributes := make(map[string]attribute, len(attributes))
+	for _, attr := range attributes {
+		newAttributes[attr.name] = attr
 
-		// and the normal attribute logic here
-		default:
-			jsElement.Set(
-				strings.ToLower(attributeName),
-				fmt.Sprintf("%v", attributeValue), // parsing interface{}
-			)
+		if old, ok := v.attributes[attr.name]; !ok || old != attr {
+			dom.SetAttribute(v.node, attr.name, attr.value)
+
+			// the style attribute replaces the css properties
+			if attr.name == "style" {
+				v.styles = nil
+			}
+		}
+	}
+
+	for name := range v.attributes {
+		if _, ok := newAttributes[name]; !ok {
+			dom.RemoveAttribute(v.node, name)
+
+			if name == "style" {
+				v.styles = nil
+			}
+		}
+	}
+
+	v.attributes = newAttributes
+
+	// handlers that are not there anymore are removed
+	for event, remove := range v.listeners {
+		if _, ok := events[event]; !ok {
+			remove()
+			delete(v.listeners, event)
+		}
+	}
+
+	if v.listeners == nil && len(events) > 0 {
+		v.listeners = make(map[string]func())
+	}
+
+	for event := range events {
+		if _, ok := v.listeners[event]; ok {
+			continue
 		}
+
+		event := event
+		// event listener with custom action for the event handler,
+		// the handler is looked up at each call so re-rendered
+		// elements replace it without adding a new listener
+		v.listeners[event] = dom.AddEventListener(v.node, event, func(raw RawEvent) {
+			vnodesMutex.Lock()
+			handler := v.events[event]
+			vnodesMutex.Unlock()
+
+			if handler != nil {
+				handler(NewEvent(raw))
+			}
+		})
 	}
+
+	v.events = events
 }
 
-func buildElement(elem Element, parent js.Value) js.Value {
-	document := js.Global().Get("document")
+// fragments anchors comments
+const (
+	fragmentStart = "["
+	fragmentEnd   = "]"
+)
+
+// buildElement creates the vnode of the given element and its dom tree,
+// the node is inserted in parent before ref (or appended if ref is nil)
+func buildElement(elem Element, parent Node, ref Node) *vnode {
+	v := &vnode{
+		el:         elem,
+		name:       elem.GetElName(),
+		key:        elementKey(elem),
+		parentNode: parent,
+	}
+
+	vnodes[elem] = v
 
 	switch el := elem.(type) {
-	case *TextEl: // fake element for text
-		parent.Set("innerText", el.InnerText)
+	case *TextEl: // text node
+		v.text = el.InnerText
+		v.node = dom.CreateTextNode(el.InnerText)
 
-		// return parrent because we just added text content
-		// in parent element and we don't spawn a new element
-		return parent
+		el.ElValue = v.node
+
+		dom.InsertBefore(parent, v.node, ref)
 
 	case *EmptyEl:
-		return parent
 
 	case *SliceEl:
-		// here we don't want to create and append to the dom
-		// a slice element, just append childs to parent, so:
-		// loop over each child element and create the tree
-		//
-		// NOTE: we'll have to create a div elem because slides should
-		// have a valid elValue property as this, we can update them
-		jsElement := document.Call("createElement", "div")
+		// the slice is a fragment, its childs are inserted in the
+		// parent between two anchors, so it can be patched in place
+		v.fragment = true
+		v.start = dom.CreateComment(fragmentStart)
+		v.end = dom.CreateComment(fragmentEnd)
 
-		for _, child := range el.GetChilds() {
-			buildElement(child, jsElement)
-		}
+		dom.InsertBefore(parent, v.start, ref)
+		dom.InsertBefore(parent, v.end, ref)
 
-		el.ElValue = jsElement
+		v.children = buildChildren(v, el.GetChilds(), v.end)
 
-		return parent
+		el.ElValue = v.start
 
-	default:
-		jsElement := document.Call("createElement", el.GetElName())
+	case *DynamicEl:
+		// the rendered child takes the dynamic element place
+		v.transparent = true
 
-		buildElementAttributes(el, jsElement)
+		v.children = buildChildren(v, []Element{el.render()}, ref)
 
-		// loop over each child element and create the tree
-		for _, child := range el.GetChilds() {
-			buildElement(child, jsElement)
+		el.ElValue = v.children[0].domNode()
+
+		if el.Mounted != nil {
+			lifecycle = append(lifecycle, el.Mounted)
 		}
 
-		// set the ElValue field
-		elValueField := reflect.ValueOf(el).Elem().FieldByName("ElValue")
-		if elValueField.CanSet() {
-			elValueField.Set(reflect.ValueOf(jsElement))
+	default:
+		v.node = dom.CreateElement(el.GetElName())
+
+		buildElementAttributes(v, el)
+		buildElementStyles(v, el)
+
+		// textarea value is the element content
+		if textarea, ok := el.(*TextareaEl); ok && textarea.Value != "" {
+			v.text = textarea.Value
+			dom.SetText(v.node, textarea.Value)
 		}
 
-		// spawn (append to the dom) the new element
-		parent.Call("appendChild", jsElement)
+		// loop over each child element and create the tree
+		v.children = buildChildren(v, el.GetChilds(), nil)
+
+		buildElementBinding(v, el)
+
+		setElValue(el, v.node)
 
-		return jsElement
+		// spawn (insert in the dom) the new element
+		dom.InsertBefore(parent, v.node, ref)
 	}
+
+	return v
 }
 
-// DOM builder
-func Build(element Element) js.Func {
-	return js.FuncOf(func(this js.Value, vals []js.Value) any {
-		document := js.Global().Get("document")
-		body := document.Get("body")
+// buildChildren builds the childs of parent, they are inserted before
+// ref (or appended if ref is nil) in the parent container
+func buildChildren(parent *vnode, elems []Element, ref Node) []*vnode {
+	children := make([]*vnode, len(elems))
 
-		// internal function that will spawn element
-		// in the dom, attached to the given parent js element
+	for i, child := range elems {
+		children[i] = buildElement(child, parent.container(), ref)
+		children[i].parent = parent
+	}
 
-		buildElement(element, body)
+	return children
+}
 
-		// runtime loaded function
-		js.Global().Call("loaded")
+// set the ElValue field
+func setElValue(el Element, node Node) {
+	elValueField := reflect.ValueOf(el).Elem().FieldByName("ElValue")
+	if elValueField.CanSet() {
+		elValueField.Set(reflect.ValueOf(&node).Elem())
+	}
+}
 
-		// runtime start the state manager
-		js.Global().Call("stateManagerStart")
+// attribute is a dom ready attribute
+type attribute struct {
+	name  string
+	value string
+	// boolean attributes are rendered without value
+	boolean bool
+}
 
-		return nil
+// elementAttributes returns the sorted dom attributes of the given
+// element with data-* and aria-* maps flattened and lower cased names,
+// and its event handlers by event name (e.g. OnClick -> click)
+func elementAttributes(elem Element) ([]attribute, map[string]EventListener) {
+	elementMap := fieldsToMap(elem)
+
+	attributes := []attribute{}
+	events := make(map[string]EventListener)
+
+	for attributeName, attributeValue := range elementMap {
+		// with this we can do a specific logic for events
+		switch attr := attributeValue.(type) {
+		case EventListener: // event handler case
+			events[strings.ToLower(
+				strings.TrimPrefix(attributeName, "On"), // e.g. OnClick -> Click -> click
+			)] = attr
+
+		case EventHandler: // custom event handler attribute
+			events[strings.ToLower(
+				strings.TrimPrefix(attributeName, "On"),
+			)] = toListener(attr, plainEvent)
+
+		case map[string]interface{}: // data-* and aria-* attributes
+			prefix := strings.ToLower(attributeName) + "-"
+
+			for key, value := range attr {
+				attributes = append(attributes, attribute{
+					name:  prefix + key,
+					value: fmt.Sprintf("%v", value),
+				})
+			}
+
+		case bool: // false values are already skipped
+			attributes = append(attributes, attribute{
+				name:    strings.ToLower(attributeName),
+				boolean: true,
+			})
+
+		// and the normal attribute logic here
+		case *Binding: // set as a dom property, see buildElementBinding
+
+		case Styles: // set as css properties, see buildElementStyles
+
+		default:
+			// keys are only used to update lists
+			if attributeName == "Key" {
+				continue
+			}
+
+			// textarea value is the element content
+			if _, ok := elem.(*TextareaEl); ok && attributeName == "Value" {
+				continue
+			}
+
+			// other functions (e.g. custom attributes) can't be attributes
+			if reflect.ValueOf(attr).Kind() == reflect.Func {
+				continue
+			}
+
+			attributes = append(attributes, attribute{
+				name:  strings.ToLower(attributeName),
+				value: fmt.Sprintf("%v", attributeValue), // parsing interface{}
+			})
+		}
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].name < attributes[j].name
 	})
+
+	return attributes, events
 }
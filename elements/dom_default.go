@@ -0,0 +1,5 @@
+//go:build !(js && wasm)
+
+package elements
+
+func defaultDOM() DOM { return NewMemoryDOM() }
@@ -0,0 +1,222 @@
+//go:build js && wasm
+
+package elements
+
+import (
+	"strings"
+	"syscall/js"
+)
+
+func defaultDOM() DOM { return JSDOM{} }
+
+// JSDOM is the browser backend, every node is a js.Value
+// and every call goes through syscall/js
+type JSDOM struct{}
+
+func (JSDOM) document() js.Value { return js.Global().Get("document") }
+
+func (d JSDOM) Body() Node { return d.document().Get("body") }
+
+func (d JSDOM) QuerySelector(selector string) (node Node) {
+	// an invalid selector throws
+	defer func() {
+		if recover() != nil {
+			node = nil
+		}
+	}()
+
+	found := d.document().Call("querySelector", selector)
+	if found.IsNull() {
+		return nil
+	}
+
+	return found
+}
+
+func (d JSDOM) CreateElement(tag string) Node {
+	return d.document().Call("createElement", tag)
+}
+
+func (d JSDOM) CreateTextNode(text string) Node {
+	return d.document().Call("createTextNode", text)
+}
+
+func (d JSDOM) CreateComment(text string) Node {
+	return d.document().Call("createComment", text)
+}
+
+func (JSDOM) SetNodeValue(node Node, text string) {
+	node.(js.Value).Set("nodeValue", text)
+}
+
+func (JSDOM) SetAttribute(node Node, name, value string) {
+	jsNode := node.(js.Value)
+	jsNode.Call("setAttribute", name, value)
+
+	// value is also a property of inputs, the attribute
+	// is only the default value
+	if name == "value" {
+		jsNode.Set(name, value)
+	}
+}
+
+func (JSDOM) RemoveAttribute(node Node, name string) {
+	node.(js.Value).Call("removeAttribute", name)
+}
+
+func (JSDOM) GetProperty(node Node, name string) interface{} {
+	jsNode := node.(js.Value)
+
+	if name == SelectedValues {
+		values := []string{}
+
+		options := jsNode.Get("selectedOptions")
+		for i := 0; i < options.Length(); i++ {
+			values = append(values, options.Index(i).Get("value").String())
+		}
+
+		return values
+	}
+
+	v := jsNode.Get(name)
+	switch v.Type() {
+	case js.TypeString:
+		return v.String()
+	case js.TypeNumber:
+		return v.Float()
+	case js.TypeBoolean:
+		return v.Bool()
+	default:
+		return nil
+	}
+}
+
+func (JSDOM) SetProperty(node Node, name string, value interface{}) {
+	jsNode := node.(js.Value)
+
+	if name == SelectedValues {
+		selected := make(map[string]bool)
+		values, _ := value.([]string)
+		for _, v := range values {
+			selected[v] = true
+		}
+
+		options := jsNode.Get("options")
+		for i := 0; i < options.Length(); i++ {
+			option := options.Index(i)
+			option.Set("selected", selected[option.Get("value").String()])
+		}
+
+		return
+	}
+
+	jsNode.Set(name, value)
+}
+
+func (JSDOM) SetStyleProperty(node Node, name, value string) {
+	node.(js.Value).Get("style").Call("setProperty", name, value)
+}
+
+func (JSDOM) RemoveStyleProperty(node Node, name string) {
+	node.(js.Value).Get("style").Call("removeProperty", name)
+}
+
+func (JSDOM) AppendChild(parent, child Node) {
+	parent.(js.Value).Call("appendChild", child.(js.Value))
+}
+
+func (JSDOM) InsertBefore(parent, child, ref Node) {
+	refNode := js.Null()
+	if ref != nil {
+		refNode = ref.(js.Value)
+	}
+
+	parent.(js.Value).Call("insertBefore", child.(js.Value), refNode)
+}
+
+func (JSDOM) RemoveChild(parent, child Node) {
+	parent.(js.Value).Call("removeChild", child.(js.Value))
+}
+
+func (JSDOM) ChildNodes(node Node) []Node {
+	childNodes := node.(js.Value).Get("childNodes")
+
+	nodes := make([]Node, childNodes.Length())
+	for i := range nodes {
+		nodes[i] = childNodes.Index(i)
+	}
+
+	return nodes
+}
+
+func (JSDOM) NodeName(node Node) string {
+	return strings.ToLower(node.(js.Value).Get("nodeName").String())
+}
+
+func (JSDOM) AddEventListener(node Node, event string, handler func(RawEvent)) func() {
+	listener := js.FuncOf(func(this js.Value, vals []js.Value) any {
+		handler(JSEvent{Value: vals[0]})
+		return nil
+	})
+
+	node.(js.Value).Call("addEventListener", event, listener)
+
+	return func() {
+		node.(js.Value).Call("removeEventListener", event, listener)
+		listener.Release()
+	}
+}
+
+func (JSDOM) SetText(node Node, text string) {
+	// the text of a textarea is only its default value, once
+	// the user typed in it only its value is shown
+	if node.(js.Value).Get("nodeName").String() == "TEXTAREA" {
+		node.(js.Value).Set("value", text)
+		return
+	}
+
+	node.(js.Value).Set("innerText", text)
+}
+
+// JSEvent is the browser event given to the listeners
+type JSEvent struct {
+	Value js.Value
+}
+
+func (e JSEvent) Get(path ...string) interface{} {
+	v := e.Value
+	for _, property := range path {
+		if v.Type() != js.TypeObject && v.Type() != js.TypeFunction {
+			return nil
+		}
+
+		v = v.Get(property)
+	}
+
+	switch v.Type() {
+	case js.TypeString:
+		return v.String()
+	case js.TypeNumber:
+		return v.Float()
+	case js.TypeBoolean:
+		return v.Bool()
+	default:
+		return nil
+	}
+}
+
+func (e JSEvent) Call(method string) { e.Value.Call(method) }
+
+// DOM builder, the element is built in the body
+//
+// NOTE: runtime.Mount can build an app in another element
+func Build(element Element) js.Func {
+	return js.FuncOf(func(this js.Value, vals []js.Value) any {
+		// internal function that will spawn element
+		// in the dom, attached to the given parent js element
+
+		Mount(element, dom.Body())
+
+		return nil
+	})
+}
@@ -0,0 +1,352 @@
+package elements
+
+import "strings"
+
+// MemoryNode is a node of the in memory backend, text and comment
+// nodes have the "#text" and "#comment" tags and their text in Text
+type MemoryNode struct {
+	Tag        string
+	Attributes map[string]string
+	Text       string
+	// Properties are the dom properties set with SetProperty,
+	// they can be changed to simulate the user (e.g. "value")
+	Properties map[string]interface{}
+	// Styles are the css properties set with SetStyleProperty
+	Styles    map[string]string
+	Parent    *MemoryNode
+	Children  []*MemoryNode
+	listeners map[string][]*memoryListener
+}
+
+type memoryListener struct {
+	handler func(RawEvent)
+}
+
+// Dispatch calls every listener registered for the given event
+// with an event targeting the node
+func (n *MemoryNode) Dispatch(event string) *MemoryEvent {
+	ev := &MemoryEvent{
+		Target:     n,
+		Properties: map[string]interface{}{"type": event},
+	}
+
+	n.DispatchEvent(event, ev)
+
+	return ev
+}
+
+// DispatchEvent calls every listener registered for the given event
+func (n *MemoryNode) DispatchEvent(event string, ev *MemoryEvent) {
+	for _, listener := range n.listeners[event] {
+		listener.handler(ev)
+	}
+}
+
+// Listeners returns the number of listeners of the given event
+func (n *MemoryNode) Listeners(event string) int {
+	return len(n.listeners[event])
+}
+
+// MemoryEvent is the event given to the in memory backend listeners,
+// the target properties are the target node attributes
+type MemoryEvent struct {
+	Target             *MemoryNode
+	Properties         map[string]interface{}
+	DefaultPrevented   bool
+	PropagationStopped bool
+}
+
+func (e *MemoryEvent) Get(path ...string) interface{} {
+	if len(path) == 2 && path[0] == "target" && e.Target != nil {
+		value, ok := e.Target.Attributes[path[1]]
+
+		// boolean attributes (e.g. checked) are present or not
+		if path[1] == "checked" || path[1] == "selected" || path[1] == "disabled" {
+			return ok
+		}
+		if !ok {
+			return nil
+		}
+
+		return value
+	}
+
+	if len(path) != 1 {
+		return nil
+	}
+
+	return e.Properties[path[0]]
+}
+
+func (e *MemoryEvent) Call(method string) {
+	switch method {
+	case "preventDefault":
+		e.DefaultPrevented = true
+	case "stopPropagation":
+		e.PropagationStopped = true
+	}
+}
+
+// MemoryDOM is a pure go backend that keeps the whole tree in
+// memory, it's used by default outside of the browser so views
+// can be built, updated and tested natively
+type MemoryDOM struct {
+	body *MemoryNode
+}
+
+func NewMemoryDOM() *MemoryDOM {
+	return &MemoryDOM{
+		body: &MemoryNode{
+			Tag:        "body",
+			Attributes: make(map[string]string),
+		},
+	}
+}
+
+func (d *MemoryDOM) Body() Node { return d.body }
+
+// QuerySelector only supports compound selectors of a
+// tag, an id and classes (e.g. "#app" or "div.app")
+func (d *MemoryDOM) QuerySelector(selector string) Node {
+	if d.body.matches(selector) {
+		return d.body
+	}
+
+	if n := d.body.query(selector); n != nil {
+		return n
+	}
+
+	return nil
+}
+
+func (n *MemoryNode) query(selector string) *MemoryNode {
+	for _, child := range n.Children {
+		if child.matches(selector) {
+			return child
+		}
+
+		if found := child.query(selector); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func (n *MemoryNode) matches(selector string) bool {
+	if selector == "" {
+		return false
+	}
+
+	// split the selector before each "#" and "."
+	parts := []string{}
+	start := 0
+	for i, c := range selector {
+		if i > 0 && (c == '#' || c == '.') {
+			parts = append(parts, selector[start:i])
+			start = i
+		}
+	}
+	parts = append(parts, selector[start:])
+
+	for _, part := range parts {
+		switch {
+		case strings.HasPrefix(part, "#"):
+			if n.Attributes["id"] != part[1:] {
+				return false
+			}
+
+		case strings.HasPrefix(part, "."):
+			found := false
+			for _, class := range strings.Fields(n.Attributes["class"]) {
+				found = found || class == part[1:]
+			}
+			if !found {
+				return false
+			}
+
+		case part != "*" && !strings.EqualFold(part, n.Tag):
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *MemoryDOM) CreateElement(tag string) Node {
+	return &MemoryNode{
+		Tag:        tag,
+		Attributes: make(map[string]string),
+	}
+}
+
+func (d *MemoryDOM) CreateTextNode(text string) Node {
+	return &MemoryNode{
+		Tag:        "#text",
+		Attributes: make(map[string]string),
+		Text:       text,
+	}
+}
+
+func (d *MemoryDOM) CreateComment(text string) Node {
+	return &MemoryNode{
+		Tag:        "#comment",
+		Attributes: make(map[string]string),
+		Text:       text,
+	}
+}
+
+func (d *MemoryDOM) SetNodeValue(node Node, text string) {
+	node.(*MemoryNode).Text = text
+}
+
+func (d *MemoryDOM) SetAttribute(node Node, name, value string) {
+	n := node.(*MemoryNode)
+
+	// like in the browser, the style attribute replaces the properties
+	if name == "style" {
+		n.Styles = nil
+	}
+
+	n.Attributes[name] = value
+}
+
+func (d *MemoryDOM) RemoveAttribute(node Node, name string) {
+	delete(node.(*MemoryNode).Attributes, name)
+}
+
+func (d *MemoryDOM) GetProperty(node Node, name string) interface{} {
+	n := node.(*MemoryNode)
+
+	if value, ok := n.Properties[name]; ok {
+		return value
+	}
+
+	// like in the browser, properties default to the attributes
+	switch name {
+	case "checked", "selected", "disabled":
+		_, ok := n.Attributes[name]
+		return ok
+
+	case SelectedValues:
+		values := []string{}
+		for _, option := range n.Children {
+			if _, ok := option.Attributes["selected"]; ok {
+				values = append(values, option.Attributes["value"])
+			}
+		}
+		return values
+	}
+
+	return n.Attributes[name]
+}
+
+func (d *MemoryDOM) SetProperty(node Node, name string, value interface{}) {
+	n := node.(*MemoryNode)
+
+	if n.Properties == nil {
+		n.Properties = make(map[string]interface{})
+	}
+	n.Properties[name] = value
+}
+
+func (d *MemoryDOM) SetStyleProperty(node Node, name, value string) {
+	n := node.(*MemoryNode)
+
+	if n.Styles == nil {
+		n.Styles = make(map[string]string)
+	}
+	n.Styles[name] = value
+}
+
+func (d *MemoryDOM) RemoveStyleProperty(node Node, name string) {
+	delete(node.(*MemoryNode).Styles, name)
+}
+
+func (d *MemoryDOM) AppendChild(parent, child Node) {
+	d.InsertBefore(parent, child, nil)
+}
+
+func (d *MemoryDOM) InsertBefore(parent, child, ref Node) {
+	p, c := parent.(*MemoryNode), child.(*MemoryNode)
+
+	if c.Parent != nil {
+		d.RemoveChild(c.Parent, c)
+	}
+
+	c.Parent = p
+
+	if ref != nil {
+		for i, node := range p.Children {
+			if node == ref.(*MemoryNode) {
+				p.Children = append(p.Children[:i], append([]*MemoryNode{c}, p.Children[i:]...)...)
+				return
+			}
+		}
+	}
+
+	p.Children = append(p.Children, c)
+}
+
+func (d *MemoryDOM) RemoveChild(parent, child Node) {
+	p, c := parent.(*MemoryNode), child.(*MemoryNode)
+
+	for i, node := range p.Children {
+		if node == c {
+			p.Children = append(p.Children[:i], p.Children[i+1:]...)
+			c.Parent = nil
+			return
+		}
+	}
+}
+
+func (d *MemoryDOM) ChildNodes(node Node) []Node {
+	children := node.(*MemoryNode).Children
+
+	nodes := make([]Node, len(children))
+	for i, child := range children {
+		nodes[i] = child
+	}
+
+	return nodes
+}
+
+func (d *MemoryDOM) NodeName(node Node) string {
+	return strings.ToLower(node.(*MemoryNode).Tag)
+}
+
+func (d *MemoryDOM) AddEventListener(node Node, event string, handler func(RawEvent)) func() {
+	n := node.(*MemoryNode)
+
+	if n.listeners == nil {
+		n.listeners = make(map[string][]*memoryListener)
+	}
+
+	listener := &memoryListener{handler: handler}
+	n.listeners[event] = append(n.listeners[event], listener)
+
+	return func() {
+		for i, l := range n.listeners[event] {
+			if l == listener {
+				n.listeners[event] = append(n.listeners[event][:i], n.listeners[event][i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (d *MemoryDOM) SetText(node Node, text string) {
+	n := node.(*MemoryNode)
+
+	// same as innerText, every child is replaced by the text
+	for _, child := range n.Children {
+		child.Parent = nil
+	}
+	n.Children = nil
+	n.Text = text
+
+	// like in the browser, the value typed in a textarea is replaced
+	if n.Tag == "textarea" {
+		d.SetProperty(n, "value", text)
+	}
+}
@@ -0,0 +1,167 @@
+package elements
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// mount builds the element in the body of a new memory backend
+func mount(t *testing.T, el Element) *MemoryNode {
+	t.Helper()
+
+	d := NewMemoryDOM()
+	SetDOM(d)
+
+	Mount(el, d.Body())
+
+	return d.Body().(*MemoryNode)
+}
+
+// memoryHTML returns the html of the memory node childs, comments
+// are written like in the page so the anchors can be checked
+func memoryHTML(n *MemoryNode) string {
+	var b strings.Builder
+
+	for _, child := range n.Children {
+		writeMemoryNode(&b, child)
+	}
+
+	return b.String()
+}
+
+func writeMemoryNode(b *strings.Builder, n *MemoryNode) {
+	switch n.Tag {
+	case "#text":
+		b.WriteString(n.Text)
+		return
+
+	case "#comment":
+		b.WriteString("<!--" + n.Text + "-->")
+		return
+	}
+
+	names := make([]string, 0, len(n.Attributes))
+	for name := range n.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b.WriteString("<" + n.Tag)
+	for _, name := range names {
+		b.WriteString(" " + name + `="` + n.Attributes[name] + `"`)
+	}
+	b.WriteString(">")
+
+	for _, child := range n.Children {
+		writeMemoryNode(b, child)
+	}
+
+	b.WriteString("</" + n.Tag + ">")
+}
+
+func TestMount(t *testing.T) {
+	tests := []struct {
+		name string
+		el   Element
+		want string
+	}{
+		{
+			name: "element with attributes",
+			el:   Div(ID("app"), Class("a b"))(),
+			want: `<div class="a b" id="app"></div>`,
+		},
+		{
+			name: "text nodes",
+			el:   P()(Text("hello "), Text("world")),
+			want: `<p>hello world</p>`,
+		},
+		{
+			name: "nested elements",
+			el:   Ul()(Li()(Text("a")), Li()(Text("b"))),
+			want: `<ul><li>a</li><li>b</li></ul>`,
+		},
+		{
+			name: "empty elements are not built",
+			el:   Div()(&EmptyEl{}, Span()()),
+			want: `<div><span></span></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := mount(t, test.el)
+
+			if got := memoryHTML(body); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMountEvents(t *testing.T) {
+	clicks := 0
+	body := mount(t, Button(OnClick(func() { clicks++ }))(Text("+")))
+
+	button := body.Children[0]
+	button.Dispatch("click")
+	button.Dispatch("click")
+
+	if clicks != 2 {
+		t.Errorf("got %d clicks, want 2", clicks)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	mount(t, Div(ID("app"))(Span(Class("name"))()))
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{target: "#app", want: "div"},
+		{target: "app", want: "div"},
+		{target: "span.name", want: "span"},
+		{target: "missing", want: ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		if node := Query(test.target); node != nil {
+			got = node.(*MemoryNode).Tag
+		}
+
+		if got != test.want {
+			t.Errorf("Query(%q) = %q, want %q", test.target, got, test.want)
+		}
+	}
+}
+
+func TestUnmount(t *testing.T) {
+	disposed := 0
+	dynamic := Dynamic(func() Element { return Span()(Text("b")) })
+	dynamic.Dispose = func() { disposed++ }
+
+	clicks := 0
+	app := Div()(Button(OnClick(func() { clicks++ }))(Text("+")), dynamic)
+	other := P()(Text("other"))
+
+	body := mount(t, app)
+	Mount(other, body)
+
+	button := body.Children[0].Children[0]
+	Unmount(app)
+
+	if got, want := memoryHTML(body), `<p>other</p>`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if disposed != 1 {
+		t.Errorf("the dynamic element was disposed %d times, want 1", disposed)
+	}
+
+	// the listeners are released with the nodes
+	button.Dispatch("click")
+	if clicks != 0 {
+		t.Errorf("got %d clicks after unmount, want 0", clicks)
+	}
+}
@@ -3,7 +3,6 @@ package elements
 import (
 	"fmt"
 	"log"
-	"syscall/js"
 )
 
 // this will help implementing every elements
@@ -48,13 +47,13 @@ func elementAutoCloseImpl(
 type EmptyEl struct {
 	BasicElement
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *EmptyEl) GetChilds() []Element   { return []Element{} }
 func (e *EmptyEl) AppendChild(el Element) {}
 func (e *EmptyEl) GetElName() string      { return e.elName }
-func (e *EmptyEl) GetElValue() js.Value   { return e.ElValue }
+func (e *EmptyEl) GetElValue() Node       { return e.ElValue }
 
 // empty value, will not be rendered in the DOM
 var None = EmptyEl{
@@ -66,30 +65,96 @@ type CustomEl[T interface{}] struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 	Custom  T
 }
 
 func (e *CustomEl[T]) GetChilds() []Element   { return e.childs }
 func (e *CustomEl[T]) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *CustomEl[T]) GetElName() string      { return e.elName }
-func (e *CustomEl[T]) GetElValue() js.Value   { return e.ElValue }
+func (e *CustomEl[T]) GetElValue() Node       { return e.ElValue }
 
 func CustomElem[T interface{}](name string, attributes ...Attribute) func(...Element) Element {
 	el := &CustomEl[T]{elName: name}
 	return elementImpl(el, attributes)
 }
 
+// this element is a fragment, its childs are inserted directly in
+// the parent between two comments anchors, so it can be updated
+// in place (e.g. the list returned by For, Each and Each2)
 type SliceEl struct {
 	BasicElement
 	childs  []Element
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SliceEl) GetChilds() []Element   { return e.childs }
 func (e *SliceEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SliceEl) GetElName() string      { return "slice" }
-func (e *SliceEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SliceEl) GetElValue() Node       { return e.ElValue }
+
+// Fragment returns an element rendering the given
+// elements without a wrapper node
+//
+// example:
+//
+//	Fragment(
+//		Dt()(Text("Go")),
+//		Dd()(Text("a programming language")),
+//	)
+func Fragment(elements ...Element) Element {
+	return &SliceEl{childs: elements}
+}
+
+// this element is rendered by calling its render function, it
+// doesn't exist in the dom (its child takes its place) and an
+// Update on it calls the render function again and patches
+// the result, so it's the reactive part of an element tree
+type DynamicEl struct {
+	BasicElement
+	Render func() Element
+	// Mounted is called once the element is built and in the dom,
+	// Updated each time it's rendered again and Dispose when it's
+	// removed or replaced by another one
+	Mounted func()
+	Updated func()
+	Dispose func()
+	// Component identifies the component that created the element
+	// and Instance is its instance, when the element is replaced by
+	// one of the same component, the instance is given to the new one
+	Component interface{}
+	Instance  interface{}
+	child     Element
+	ElValue   Node
+}
+
+func (e *DynamicEl) GetChilds() []Element {
+	if e.child == nil {
+		e.render()
+	}
+
+	return []Element{e.child}
+}
+func (e *DynamicEl) AppendChild(el Element) {}
+func (e *DynamicEl) GetElName() string      { return "dynamic" }
+func (e *DynamicEl) GetElValue() Node       { return e.ElValue }
+
+func (e *DynamicEl) render() Element {
+	e.child = nil
+	if e.Render != nil {
+		e.child = e.Render()
+	}
+
+	if e.child == nil {
+		e.child = &EmptyEl{elName: "none"}
+	}
+
+	return e.child
+}
+
+func Dynamic(render func() Element) *DynamicEl {
+	return &DynamicEl{Render: render}
+}
 
 // this element is just an implementation of raw text
 // and should not have neither children nor attributes
@@ -97,13 +162,13 @@ type TextEl struct {
 	BasicElement
 	InnerText string
 	elName    string
-	ElValue   js.Value
+	ElValue   Node
 }
 
 func (e *TextEl) GetChilds() []Element   { return []Element{} }
 func (e *TextEl) AppendChild(el Element) {}
 func (e *TextEl) GetElName() string      { return e.elName }
-func (e *TextEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TextEl) GetElValue() Node       { return e.ElValue }
 
 func Text(text string) *TextEl {
 	el := &TextEl{elName: "rawtext"}
@@ -133,13 +198,13 @@ type AEl struct {
 	Target  string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *AEl) GetChilds() []Element   { return e.childs }
 func (e *AEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *AEl) GetElName() string      { return e.elName }
-func (e *AEl) GetElValue() js.Value   { return e.ElValue }
+func (e *AEl) GetElValue() Node       { return e.ElValue }
 
 func A(attributes ...Attribute) func(...Element) Element {
 	el := &AEl{elName: "a"}
@@ -151,13 +216,13 @@ type AbbrEl struct {
 	Title   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *AbbrEl) GetChilds() []Element   { return e.childs }
 func (e *AbbrEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *AbbrEl) GetElName() string      { return e.elName }
-func (e *AbbrEl) GetElValue() js.Value   { return e.ElValue }
+func (e *AbbrEl) GetElValue() Node       { return e.ElValue }
 
 func Abbr(attributes ...Attribute) func(...Element) Element {
 	el := &AbbrEl{elName: "abbr"}
@@ -168,13 +233,13 @@ type AddressEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *AddressEl) GetChilds() []Element   { return e.childs }
 func (e *AddressEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *AddressEl) GetElName() string      { return e.elName }
-func (e *AddressEl) GetElValue() js.Value   { return e.ElValue }
+func (e *AddressEl) GetElValue() Node       { return e.ElValue }
 
 func Address(attributes ...Attribute) func(...Element) Element {
 	el := &AddressEl{elName: "address"}
@@ -185,13 +250,13 @@ type ArticleEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ArticleEl) GetChilds() []Element   { return e.childs }
 func (e *ArticleEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ArticleEl) GetElName() string      { return e.elName }
-func (e *ArticleEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ArticleEl) GetElValue() Node       { return e.ElValue }
 
 func Article(attributes ...Attribute) func(...Element) Element {
 	el := &ArticleEl{elName: "article"}
@@ -202,13 +267,13 @@ type AsideEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *AsideEl) GetChilds() []Element   { return e.childs }
 func (e *AsideEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *AsideEl) GetElName() string      { return e.elName }
-func (e *AsideEl) GetElValue() js.Value   { return e.ElValue }
+func (e *AsideEl) GetElValue() Node       { return e.ElValue }
 
 func Aside(attributes ...Attribute) func(...Element) Element {
 	el := &AsideEl{elName: "aside"}
@@ -221,13 +286,13 @@ type AudioEl struct {
 	Controls bool
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *AudioEl) GetChilds() []Element   { return e.childs }
 func (e *AudioEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *AudioEl) GetElName() string      { return e.elName }
-func (e *AudioEl) GetElValue() js.Value   { return e.ElValue }
+func (e *AudioEl) GetElValue() Node       { return e.ElValue }
 
 func Audio(attributes ...Attribute) func(...Element) Element {
 	el := &AudioEl{elName: "audio"}
@@ -239,13 +304,13 @@ type BaseEl struct {
 	Href    string
 	Target  string
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *BaseEl) GetChilds() []Element   { return []Element{} }
 func (e *BaseEl) AppendChild(el Element) {}
 func (e *BaseEl) GetElName() string      { return e.elName }
-func (e *BaseEl) GetElValue() js.Value   { return e.ElValue }
+func (e *BaseEl) GetElValue() Node       { return e.ElValue }
 
 func Base(attributes ...Attribute) Element {
 	el := &BaseEl{elName: "base"}
@@ -256,13 +321,13 @@ type BdiEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *BdiEl) GetChilds() []Element   { return e.childs }
 func (e *BdiEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *BdiEl) GetElName() string      { return e.elName }
-func (e *BdiEl) GetElValue() js.Value   { return e.ElValue }
+func (e *BdiEl) GetElValue() Node       { return e.ElValue }
 
 func Bdi(attributes ...Attribute) func(...Element) Element {
 	el := &BdiEl{elName: "bdi"}
@@ -274,13 +339,13 @@ type BdoEl struct {
 	Dir     string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *BdoEl) GetChilds() []Element   { return e.childs }
 func (e *BdoEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *BdoEl) GetElName() string      { return e.elName }
-func (e *BdoEl) GetElValue() js.Value   { return e.ElValue }
+func (e *BdoEl) GetElValue() Node       { return e.ElValue }
 
 func Bdo(attributes ...Attribute) func(...Element) Element {
 	el := &BdoEl{elName: "bdo"}
@@ -292,13 +357,13 @@ type BlockquoteEl struct {
 	Cite    string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *BlockquoteEl) GetChilds() []Element   { return e.childs }
 func (e *BlockquoteEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *BlockquoteEl) GetElName() string      { return e.elName }
-func (e *BlockquoteEl) GetElValue() js.Value   { return e.ElValue }
+func (e *BlockquoteEl) GetElValue() Node       { return e.ElValue }
 
 func Blockquote(attributes ...Attribute) func(...Element) Element {
 	el := &BlockquoteEl{elName: "blockquote"}
@@ -308,13 +373,13 @@ func Blockquote(attributes ...Attribute) func(...Element) Element {
 type BrEl struct {
 	BasicElement
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *BrEl) GetChilds() []Element   { return []Element{} }
 func (e *BrEl) AppendChild(el Element) {}
 func (e *BrEl) GetElName() string      { return e.elName }
-func (e *BrEl) GetElValue() js.Value   { return e.ElValue }
+func (e *BrEl) GetElValue() Node       { return e.ElValue }
 
 func Br(attributes ...Attribute) Element {
 	el := &BrEl{elName: "br"}
@@ -327,13 +392,13 @@ type CanvasEl struct {
 	Height  int64
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *CanvasEl) GetChilds() []Element   { return e.childs }
 func (e *CanvasEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *CanvasEl) GetElName() string      { return e.elName }
-func (e *CanvasEl) GetElValue() js.Value   { return e.ElValue }
+func (e *CanvasEl) GetElValue() Node       { return e.ElValue }
 
 func Canvas(attributes ...Attribute) func(...Element) Element {
 	el := &CanvasEl{elName: "canvas"}
@@ -344,13 +409,13 @@ type CaptionEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *CaptionEl) GetChilds() []Element   { return e.childs }
 func (e *CaptionEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *CaptionEl) GetElName() string      { return e.elName }
-func (e *CaptionEl) GetElValue() js.Value   { return e.ElValue }
+func (e *CaptionEl) GetElValue() Node       { return e.ElValue }
 
 func Caption(attributes ...Attribute) func(...Element) Element {
 	el := &CaptionEl{elName: "caption"}
@@ -361,13 +426,13 @@ type CiteEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *CiteEl) GetChilds() []Element   { return e.childs }
 func (e *CiteEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *CiteEl) GetElName() string      { return e.elName }
-func (e *CiteEl) GetElValue() js.Value   { return e.ElValue }
+func (e *CiteEl) GetElValue() Node       { return e.ElValue }
 
 func Cite(attributes ...Attribute) func(...Element) Element {
 	el := &CiteEl{elName: "cite"}
@@ -378,13 +443,13 @@ type CodeEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *CodeEl) GetChilds() []Element   { return e.childs }
 func (e *CodeEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *CodeEl) GetElName() string      { return e.elName }
-func (e *CodeEl) GetElValue() js.Value   { return e.ElValue }
+func (e *CodeEl) GetElValue() Node       { return e.ElValue }
 
 func Code(attributes ...Attribute) func(...Element) Element {
 	el := &CodeEl{elName: "code"}
@@ -396,13 +461,13 @@ type ColEl struct {
 	Span    int64
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ColEl) GetChilds() []Element   { return e.childs }
 func (e *ColEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ColEl) GetElName() string      { return e.elName }
-func (e *ColEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ColEl) GetElValue() Node       { return e.ElValue }
 
 func Col(attributes ...Attribute) func(...Element) Element {
 	el := &ColEl{elName: "col"}
@@ -413,13 +478,13 @@ type ColgroupEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ColgroupEl) GetChilds() []Element   { return e.childs }
 func (e *ColgroupEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ColgroupEl) GetElName() string      { return e.elName }
-func (e *ColgroupEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ColgroupEl) GetElValue() Node       { return e.ElValue }
 
 func Colgroup(attributes ...Attribute) func(...Element) Element {
 	el := &ColgroupEl{elName: "colgroup"}
@@ -430,13 +495,13 @@ type DatalistEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DatalistEl) GetChilds() []Element   { return e.childs }
 func (e *DatalistEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DatalistEl) GetElName() string      { return e.elName }
-func (e *DatalistEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DatalistEl) GetElValue() Node       { return e.ElValue }
 
 func Datalist(attributes ...Attribute) func(...Element) Element {
 	el := &DatalistEl{elName: "datalist"}
@@ -447,20 +512,20 @@ type DataEl struct {
 	BasicElement
 	Value   string
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 type DdEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DdEl) GetChilds() []Element   { return e.childs }
 func (e *DdEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DdEl) GetElName() string      { return e.elName }
-func (e *DdEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DdEl) GetElValue() Node       { return e.ElValue }
 
 func Dd(attributes ...Attribute) func(...Element) Element {
 	el := &DdEl{elName: "dd"}
@@ -473,13 +538,13 @@ type DelEl struct {
 	DateTime string
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *DelEl) GetChilds() []Element   { return e.childs }
 func (e *DelEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DelEl) GetElName() string      { return e.elName }
-func (e *DelEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DelEl) GetElValue() Node       { return e.ElValue }
 
 func Del(attributes ...Attribute) func(...Element) Element {
 	el := &DelEl{elName: "del"}
@@ -491,13 +556,13 @@ type DetailsEl struct {
 	Open    bool
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DetailsEl) GetChilds() []Element   { return e.childs }
 func (e *DetailsEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DetailsEl) GetElName() string      { return e.elName }
-func (e *DetailsEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DetailsEl) GetElValue() Node       { return e.ElValue }
 
 func Details(attributes ...Attribute) func(...Element) Element {
 	el := &DetailsEl{elName: "details"}
@@ -509,13 +574,13 @@ type DfnEl struct {
 	Title   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DfnEl) GetChilds() []Element   { return e.childs }
 func (e *DfnEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DfnEl) GetElName() string      { return e.elName }
-func (e *DfnEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DfnEl) GetElValue() Node       { return e.ElValue }
 
 func Dfn(attributes ...Attribute) func(...Element) Element {
 	el := &DfnEl{elName: "dfn"}
@@ -527,13 +592,13 @@ type DialogEl struct {
 	Open    bool
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DialogEl) GetChilds() []Element   { return e.childs }
 func (e *DialogEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DialogEl) GetElName() string      { return e.elName }
-func (e *DialogEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DialogEl) GetElValue() Node       { return e.ElValue }
 
 func Dialog(attributes ...Attribute) func(...Element) Element {
 	el := &DialogEl{elName: "dialog"}
@@ -544,13 +609,13 @@ type DlEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DlEl) GetChilds() []Element   { return e.childs }
 func (e *DlEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DlEl) GetElName() string      { return e.elName }
-func (e *DlEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DlEl) GetElValue() Node       { return e.ElValue }
 
 func Dl(attributes ...Attribute) func(...Element) Element {
 	el := &DlEl{elName: "dl"}
@@ -561,13 +626,13 @@ type DtEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DtEl) GetChilds() []Element   { return e.childs }
 func (e *DtEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DtEl) GetElName() string      { return e.elName }
-func (e *DtEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DtEl) GetElValue() Node       { return e.ElValue }
 
 func Dt(attributes ...Attribute) func(...Element) Element {
 	el := &DtEl{elName: "dt"}
@@ -578,13 +643,13 @@ type EmEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *EmEl) GetChilds() []Element   { return e.childs }
 func (e *EmEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *EmEl) GetElName() string      { return e.elName }
-func (e *EmEl) GetElValue() js.Value   { return e.ElValue }
+func (e *EmEl) GetElValue() Node       { return e.ElValue }
 
 func Em(attributes ...Attribute) func(...Element) Element {
 	el := &EmEl{elName: "em"}
@@ -598,13 +663,13 @@ type EmbedEl struct {
 	Width   int64
 	Height  int64
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *EmbedEl) GetChilds() []Element   { return []Element{} }
 func (e *EmbedEl) AppendChild(el Element) {}
 func (e *EmbedEl) GetElName() string      { return e.elName }
-func (e *EmbedEl) GetElValue() js.Value   { return e.ElValue }
+func (e *EmbedEl) GetElValue() Node       { return e.ElValue }
 
 func Embed(attributes ...Attribute) func(...Element) Element {
 	el := &EmbedEl{elName: "embed"}
@@ -618,13 +683,13 @@ type FieldsetEl struct {
 	Name     string
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *FieldsetEl) GetChilds() []Element   { return e.childs }
 func (e *FieldsetEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *FieldsetEl) GetElName() string      { return e.elName }
-func (e *FieldsetEl) GetElValue() js.Value   { return e.ElValue }
+func (e *FieldsetEl) GetElValue() Node       { return e.ElValue }
 
 func Fieldset(attributes ...Attribute) func(...Element) Element {
 	el := &FieldsetEl{elName: "fieldset"}
@@ -635,13 +700,13 @@ type FigcaptionEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *FigcaptionEl) GetChilds() []Element   { return e.childs }
 func (e *FigcaptionEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *FigcaptionEl) GetElName() string      { return e.elName }
-func (e *FigcaptionEl) GetElValue() js.Value   { return e.ElValue }
+func (e *FigcaptionEl) GetElValue() Node       { return e.ElValue }
 
 func Figcaption(attributes ...Attribute) func(...Element) Element {
 	el := &FigcaptionEl{elName: "figcaption"}
@@ -652,13 +717,13 @@ type FigureEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *FigureEl) GetChilds() []Element   { return e.childs }
 func (e *FigureEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *FigureEl) GetElName() string      { return e.elName }
-func (e *FigureEl) GetElValue() js.Value   { return e.ElValue }
+func (e *FigureEl) GetElValue() Node       { return e.ElValue }
 
 func Figure(attributes ...Attribute) func(...Element) Element {
 	el := &FigureEl{elName: "figure"}
@@ -669,13 +734,13 @@ type FooterEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *FooterEl) GetChilds() []Element   { return e.childs }
 func (e *FooterEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *FooterEl) GetElName() string      { return e.elName }
-func (e *FooterEl) GetElValue() js.Value   { return e.ElValue }
+func (e *FooterEl) GetElValue() Node       { return e.ElValue }
 
 func Footer(attributes ...Attribute) func(...Element) Element {
 	el := &FooterEl{elName: "footer"}
@@ -692,13 +757,13 @@ type FormEl struct {
 	Target       string
 	childs       []Element
 	elName       string
-	ElValue      js.Value
+	ElValue      Node
 }
 
 func (e *FormEl) GetChilds() []Element   { return e.childs }
 func (e *FormEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *FormEl) GetElName() string      { return e.elName }
-func (e *FormEl) GetElValue() js.Value   { return e.ElValue }
+func (e *FormEl) GetElValue() Node       { return e.ElValue }
 
 func Form(attributes ...Attribute) func(...Element) Element {
 	el := &FormEl{elName: "form"}
@@ -709,13 +774,13 @@ type H1El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H1El) GetChilds() []Element   { return e.childs }
 func (e *H1El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H1El) GetElName() string      { return e.elName }
-func (e *H1El) GetElValue() js.Value   { return e.ElValue }
+func (e *H1El) GetElValue() Node       { return e.ElValue }
 
 func H1(attributes ...Attribute) func(...Element) Element {
 	el := &H1El{elName: "h1"}
@@ -726,13 +791,13 @@ type H2El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H2El) GetChilds() []Element   { return e.childs }
 func (e *H2El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H2El) GetElName() string      { return e.elName }
-func (e *H2El) GetElValue() js.Value   { return e.ElValue }
+func (e *H2El) GetElValue() Node       { return e.ElValue }
 
 func H2(attributes ...Attribute) func(...Element) Element {
 	el := &H2El{elName: "h2"}
@@ -743,13 +808,13 @@ type H3El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H3El) GetChilds() []Element   { return e.childs }
 func (e *H3El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H3El) GetElName() string      { return e.elName }
-func (e *H3El) GetElValue() js.Value   { return e.ElValue }
+func (e *H3El) GetElValue() Node       { return e.ElValue }
 
 func H3(attributes ...Attribute) func(...Element) Element {
 	el := &H3El{elName: "h3"}
@@ -760,13 +825,13 @@ type H4El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H4El) GetChilds() []Element   { return e.childs }
 func (e *H4El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H4El) GetElName() string      { return e.elName }
-func (e *H4El) GetElValue() js.Value   { return e.ElValue }
+func (e *H4El) GetElValue() Node       { return e.ElValue }
 
 func H4(attributes ...Attribute) func(...Element) Element {
 	el := &H4El{elName: "h4"}
@@ -777,13 +842,13 @@ type H5El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H5El) GetChilds() []Element   { return e.childs }
 func (e *H5El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H5El) GetElName() string      { return e.elName }
-func (e *H5El) GetElValue() js.Value   { return e.ElValue }
+func (e *H5El) GetElValue() Node       { return e.ElValue }
 
 func H5(attributes ...Attribute) func(...Element) Element {
 	el := &H5El{elName: "h5"}
@@ -794,13 +859,13 @@ type H6El struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *H6El) GetChilds() []Element   { return e.childs }
 func (e *H6El) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *H6El) GetElName() string      { return e.elName }
-func (e *H6El) GetElValue() js.Value   { return e.ElValue }
+func (e *H6El) GetElValue() Node       { return e.ElValue }
 
 func H6(attributes ...Attribute) func(...Element) Element {
 	el := &H6El{elName: "h6"}
@@ -810,13 +875,13 @@ func H6(attributes ...Attribute) func(...Element) Element {
 type HrEl struct {
 	BasicElement
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *HrEl) GetChilds() []Element   { return []Element{} }
 func (e *HrEl) AppendChild(el Element) {}
 func (e *HrEl) GetElName() string      { return e.elName }
-func (e *HrEl) GetElValue() js.Value   { return e.ElValue }
+func (e *HrEl) GetElValue() Node       { return e.ElValue }
 
 func Hr(attributes ...Attribute) func(...Element) Element {
 	el := &HrEl{elName: "hr"}
@@ -827,13 +892,13 @@ type IEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *IEl) GetChilds() []Element   { return e.childs }
 func (e *IEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *IEl) GetElName() string      { return e.elName }
-func (e *IEl) GetElValue() js.Value   { return e.ElValue }
+func (e *IEl) GetElValue() Node       { return e.ElValue }
 
 func I(attributes ...Attribute) func(...Element) Element {
 	el := &IEl{elName: "i"}
@@ -852,13 +917,13 @@ type IframeEl struct {
 	AllowPaymentRequest bool
 	ReferrerPolicy      string
 	elName              string
-	ElValue             js.Value
+	ElValue             Node
 }
 
 func (e *IframeEl) GetChilds() []Element   { return []Element{} }
 func (e *IframeEl) AppendChild(el Element) {}
 func (e *IframeEl) GetElName() string      { return e.elName }
-func (e *IframeEl) GetElValue() js.Value   { return e.ElValue }
+func (e *IframeEl) GetElValue() Node       { return e.ElValue }
 
 func Iframe(attributes ...Attribute) func(...Element) Element {
 	el := &IframeEl{elName: "iframe"}
@@ -878,13 +943,13 @@ type ImgEl struct {
 	Sizes       string
 	SrcSet      string
 	elName      string
-	ElValue     js.Value
+	ElValue     Node
 }
 
 func (e *ImgEl) GetChilds() []Element   { return []Element{} }
 func (e *ImgEl) AppendChild(el Element) {}
 func (e *ImgEl) GetElName() string      { return e.elName }
-func (e *ImgEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ImgEl) GetElValue() Node       { return e.ElValue }
 
 func Img(attributes ...Attribute) func(...Element) Element {
 	el := &ImgEl{elName: "img"}
@@ -897,13 +962,13 @@ type InsEl struct {
 	DateTime string
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *InsEl) GetChilds() []Element   { return e.childs }
 func (e *InsEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *InsEl) GetElName() string      { return e.elName }
-func (e *InsEl) GetElValue() js.Value   { return e.ElValue }
+func (e *InsEl) GetElValue() Node       { return e.ElValue }
 
 func Ins(attributes ...Attribute) func(...Element) Element {
 	el := &InsEl{elName: "ins"}
@@ -914,13 +979,13 @@ type KbdEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *KbdEl) GetChilds() []Element   { return e.childs }
 func (e *KbdEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *KbdEl) GetElName() string      { return e.elName }
-func (e *KbdEl) GetElValue() js.Value   { return e.ElValue }
+func (e *KbdEl) GetElValue() Node       { return e.ElValue }
 
 func Kbd(attributes ...Attribute) func(...Element) Element {
 	el := &KbdEl{elName: "kbd"}
@@ -931,13 +996,13 @@ type LegendEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *LegendEl) GetChilds() []Element   { return e.childs }
 func (e *LegendEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *LegendEl) GetElName() string      { return e.elName }
-func (e *LegendEl) GetElValue() js.Value   { return e.ElValue }
+func (e *LegendEl) GetElValue() Node       { return e.ElValue }
 
 func Legend(attributes ...Attribute) func(...Element) Element {
 	el := &LegendEl{elName: "legend"}
@@ -949,13 +1014,13 @@ type LiEl struct {
 	Value   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *LiEl) GetChilds() []Element   { return e.childs }
 func (e *LiEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *LiEl) GetElName() string      { return e.elName }
-func (e *LiEl) GetElValue() js.Value   { return e.ElValue }
+func (e *LiEl) GetElValue() Node       { return e.ElValue }
 
 func Li(attributes ...Attribute) func(...Element) Element {
 	el := &LiEl{elName: "li"}
@@ -977,13 +1042,13 @@ type LinkEl struct {
 	Nonce          string
 	childs         []Element
 	elName         string
-	ElValue        js.Value
+	ElValue        Node
 }
 
 func (e *LinkEl) GetChilds() []Element   { return e.childs }
 func (e *LinkEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *LinkEl) GetElName() string      { return e.elName }
-func (e *LinkEl) GetElValue() js.Value   { return e.ElValue }
+func (e *LinkEl) GetElValue() Node       { return e.ElValue }
 
 func Link(attributes ...Attribute) func(...Element) Element {
 	el := &LinkEl{elName: "link"}
@@ -994,13 +1059,13 @@ type MainEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MainEl) GetChilds() []Element   { return e.childs }
 func (e *MainEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *MainEl) GetElName() string      { return e.elName }
-func (e *MainEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MainEl) GetElValue() Node       { return e.ElValue }
 
 func Main(attributes ...Attribute) func(...Element) Element {
 	el := &MainEl{elName: "main"}
@@ -1012,13 +1077,13 @@ type MapEl struct {
 	Name    string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MapEl) GetChilds() []Element   { return e.childs }
 func (e *MapEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *MapEl) GetElName() string      { return e.elName }
-func (e *MapEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MapEl) GetElValue() Node       { return e.ElValue }
 
 func Map(attributes ...Attribute) func(...Element) Element {
 	el := &MapEl{elName: "map"}
@@ -1029,13 +1094,13 @@ type MarkEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MarkEl) GetChilds() []Element   { return e.childs }
 func (e *MarkEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *MarkEl) GetElName() string      { return e.elName }
-func (e *MarkEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MarkEl) GetElValue() Node       { return e.ElValue }
 
 func Mark(attributes ...Attribute) func(...Element) Element {
 	el := &MarkEl{elName: "mark"}
@@ -1048,13 +1113,13 @@ type MenuEl struct {
 	Label   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MenuEl) GetChilds() []Element   { return e.childs }
 func (e *MenuEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *MenuEl) GetElName() string      { return e.elName }
-func (e *MenuEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MenuEl) GetElValue() Node       { return e.ElValue }
 
 func Menu(attributes ...Attribute) func(...Element) Element {
 	el := &MenuEl{elName: "menu"}
@@ -1067,13 +1132,13 @@ type MenuItemEl struct {
 	Label   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MenuItemEl) GetChilds() []Element   { return e.childs }
 func (e *MenuItemEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *MenuItemEl) GetElName() string      { return e.elName }
-func (e *MenuItemEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MenuItemEl) GetElValue() Node       { return e.ElValue }
 
 func MenuItem(attributes ...Attribute) func(...Element) Element {
 	el := &MenuItemEl{elName: "menuitem"}
@@ -1089,13 +1154,13 @@ type MeterEl struct {
 	High    int64
 	Optimum int64
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *MeterEl) GetChilds() []Element   { return []Element{} }
 func (e *MeterEl) AppendChild(el Element) {}
 func (e *MeterEl) GetElName() string      { return e.elName }
-func (e *MeterEl) GetElValue() js.Value   { return e.ElValue }
+func (e *MeterEl) GetElValue() Node       { return e.ElValue }
 
 func Meter(attributes ...Attribute) func(...Element) Element {
 	el := &MeterEl{elName: "meter"}
@@ -1106,13 +1171,13 @@ type NavEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *NavEl) GetChilds() []Element   { return e.childs }
 func (e *NavEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *NavEl) GetElName() string      { return e.elName }
-func (e *NavEl) GetElValue() js.Value   { return e.ElValue }
+func (e *NavEl) GetElValue() Node       { return e.ElValue }
 
 func Nav(attributes ...Attribute) func(...Element) Element {
 	el := &NavEl{elName: "nav"}
@@ -1125,13 +1190,13 @@ type ObjectEl struct {
 	Type    string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ObjectEl) GetChilds() []Element   { return e.childs }
 func (e *ObjectEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ObjectEl) GetElName() string      { return e.elName }
-func (e *ObjectEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ObjectEl) GetElValue() Node       { return e.ElValue }
 
 func Object(attributes ...Attribute) func(...Element) Element {
 	el := &ObjectEl{elName: "object"}
@@ -1145,13 +1210,13 @@ type OlEl struct {
 	Start    int64
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *OlEl) GetChilds() []Element   { return e.childs }
 func (e *OlEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *OlEl) GetElName() string      { return e.elName }
-func (e *OlEl) GetElValue() js.Value   { return e.ElValue }
+func (e *OlEl) GetElValue() Node       { return e.ElValue }
 
 func Ol(attributes ...Attribute) func(...Element) Element {
 	el := &OlEl{elName: "ol"}
@@ -1163,13 +1228,13 @@ type OptGroupEl struct {
 	Label   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *OptGroupEl) GetChilds() []Element   { return e.childs }
 func (e *OptGroupEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *OptGroupEl) GetElName() string      { return e.elName }
-func (e *OptGroupEl) GetElValue() js.Value   { return e.ElValue }
+func (e *OptGroupEl) GetElValue() Node       { return e.ElValue }
 
 func OptGroup(attributes ...Attribute) func(...Element) Element {
 	el := &OptGroupEl{elName: "optgroup"}
@@ -1181,19 +1246,20 @@ type OptionEl struct {
 	Value    string
 	Selected bool
 	Disabled bool
+	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
-func (e *OptionEl) GetChilds() []Element   { return []Element{} }
-func (e *OptionEl) AppendChild(el Element) {}
+func (e *OptionEl) GetChilds() []Element   { return e.childs }
+func (e *OptionEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *OptionEl) GetElName() string      { return e.elName }
-func (e *OptionEl) GetElValue() js.Value   { return e.ElValue }
+func (e *OptionEl) GetElValue() Node       { return e.ElValue }
 
 func Option(attributes ...Attribute) func(...Element) Element {
 	el := &OptionEl{elName: "option"}
 	return elementImpl(el, attributes)
-} // todo
+}
 
 type OutputEl struct {
 	BasicElement
@@ -1201,13 +1267,13 @@ type OutputEl struct {
 	Name    string
 	Value   string
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *OutputEl) GetChilds() []Element   { return []Element{} }
 func (e *OutputEl) AppendChild(el Element) {}
 func (e *OutputEl) GetElName() string      { return e.elName }
-func (e *OutputEl) GetElValue() js.Value   { return e.ElValue }
+func (e *OutputEl) GetElValue() Node       { return e.ElValue }
 
 func Output(attributes ...Attribute) func(...Element) Element {
 	el := &OutputEl{elName: "output"}
@@ -1219,13 +1285,13 @@ type ParamEl struct {
 	Name    string
 	Value   string
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ParamEl) GetChilds() []Element   { return []Element{} }
 func (e *ParamEl) AppendChild(el Element) {}
 func (e *ParamEl) GetElName() string      { return e.elName }
-func (e *ParamEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ParamEl) GetElValue() Node       { return e.ElValue }
 
 func Param(attributes ...Attribute) func(...Element) Element {
 	el := &ParamEl{elName: "param"}
@@ -1236,13 +1302,13 @@ type PictureEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *PictureEl) GetChilds() []Element   { return e.childs }
 func (e *PictureEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *PictureEl) GetElName() string      { return e.elName }
-func (e *PictureEl) GetElValue() js.Value   { return e.ElValue }
+func (e *PictureEl) GetElValue() Node       { return e.ElValue }
 
 func Picture(attributes ...Attribute) func(...Element) Element {
 	el := &PictureEl{elName: "picture"}
@@ -1253,13 +1319,13 @@ type PreEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *PreEl) GetChilds() []Element   { return e.childs }
 func (e *PreEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *PreEl) GetElName() string      { return e.elName }
-func (e *PreEl) GetElValue() js.Value   { return e.ElValue }
+func (e *PreEl) GetElValue() Node       { return e.ElValue }
 
 func Pre(attributes ...Attribute) func(...Element) Element {
 	el := &PreEl{elName: "pre"}
@@ -1271,13 +1337,13 @@ type ProgressEl struct {
 	Value   int64
 	Max     int64
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ProgressEl) GetChilds() []Element   { return []Element{} }
 func (e *ProgressEl) AppendChild(el Element) {}
 func (e *ProgressEl) GetElName() string      { return e.elName }
-func (e *ProgressEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ProgressEl) GetElValue() Node       { return e.ElValue }
 
 func Progress(attributes ...Attribute) func(...Element) Element {
 	el := &ProgressEl{elName: "progress"}
@@ -1289,13 +1355,13 @@ type QEl struct {
 	Cite    string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *QEl) GetChilds() []Element   { return e.childs }
 func (e *QEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *QEl) GetElName() string      { return e.elName }
-func (e *QEl) GetElValue() js.Value   { return e.ElValue }
+func (e *QEl) GetElValue() Node       { return e.ElValue }
 
 func Q(attributes ...Attribute) func(...Element) Element {
 	el := &QEl{elName: "q"}
@@ -1306,13 +1372,13 @@ type RpEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *RpEl) GetChilds() []Element   { return e.childs }
 func (e *RpEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *RpEl) GetElName() string      { return e.elName }
-func (e *RpEl) GetElValue() js.Value   { return e.ElValue }
+func (e *RpEl) GetElValue() Node       { return e.ElValue }
 
 func Rp(attributes ...Attribute) func(...Element) Element {
 	el := &RpEl{elName: "rp"}
@@ -1323,13 +1389,13 @@ type RtEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *RtEl) GetChilds() []Element   { return e.childs }
 func (e *RtEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *RtEl) GetElName() string      { return e.elName }
-func (e *RtEl) GetElValue() js.Value   { return e.ElValue }
+func (e *RtEl) GetElValue() Node       { return e.ElValue }
 
 func Rt(attributes ...Attribute) func(...Element) Element {
 	el := &RtEl{elName: "rt"}
@@ -1340,13 +1406,13 @@ type RubyEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *RubyEl) GetChilds() []Element   { return e.childs }
 func (e *RubyEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *RubyEl) GetElName() string      { return e.elName }
-func (e *RubyEl) GetElValue() js.Value   { return e.ElValue }
+func (e *RubyEl) GetElValue() Node       { return e.ElValue }
 
 func Ruby(attributes ...Attribute) func(...Element) Element {
 	el := &RubyEl{elName: "ruby"}
@@ -1357,13 +1423,13 @@ type SEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SEl) GetChilds() []Element   { return e.childs }
 func (e *SEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SEl) GetElName() string      { return e.elName }
-func (e *SEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SEl) GetElValue() Node       { return e.ElValue }
 
 func S(attributes ...Attribute) func(...Element) Element {
 	el := &SEl{elName: "s"}
@@ -1374,13 +1440,13 @@ type SampEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SampEl) GetChilds() []Element   { return e.childs }
 func (e *SampEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SampEl) GetElName() string      { return e.elName }
-func (e *SampEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SampEl) GetElValue() Node       { return e.ElValue }
 
 func Samp(attributes ...Attribute) func(...Element) Element {
 	el := &SampEl{elName: "samp"}
@@ -1391,13 +1457,13 @@ type SectionEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SectionEl) GetChilds() []Element   { return e.childs }
 func (e *SectionEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SectionEl) GetElName() string      { return e.elName }
-func (e *SectionEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SectionEl) GetElValue() Node       { return e.ElValue }
 
 func Section(attributes ...Attribute) func(...Element) Element {
 	el := &SectionEl{elName: "section"}
@@ -1409,15 +1475,16 @@ type SelectEl struct {
 	Name     string
 	Size     int64
 	Multiple bool
+	Required bool
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *SelectEl) GetChilds() []Element   { return e.childs }
 func (e *SelectEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SelectEl) GetElName() string      { return e.elName }
-func (e *SelectEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SelectEl) GetElValue() Node       { return e.ElValue }
 
 func Select(attributes ...Attribute) func(...Element) Element {
 	el := &SelectEl{elName: "select"}
@@ -1428,13 +1495,13 @@ type SmallEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SmallEl) GetChilds() []Element   { return e.childs }
 func (e *SmallEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SmallEl) GetElName() string      { return e.elName }
-func (e *SmallEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SmallEl) GetElValue() Node       { return e.ElValue }
 
 func Small(attributes ...Attribute) func(...Element) Element {
 	el := &SmallEl{elName: "small"}
@@ -1449,13 +1516,13 @@ type SourceEl struct {
 	Sizes   string
 	Media   string
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SourceEl) GetChilds() []Element   { return []Element{} }
 func (e *SourceEl) AppendChild(el Element) {}
 func (e *SourceEl) GetElName() string      { return e.elName }
-func (e *SourceEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SourceEl) GetElValue() Node       { return e.ElValue }
 
 func Source(attributes ...Attribute) func(...Element) Element {
 	el := &SourceEl{elName: "source"}
@@ -1466,13 +1533,13 @@ type SpanEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SpanEl) GetChilds() []Element   { return e.childs }
 func (e *SpanEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SpanEl) GetElName() string      { return e.elName }
-func (e *SpanEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SpanEl) GetElValue() Node       { return e.ElValue }
 
 func Span(attributes ...Attribute) func(...Element) Element {
 	el := &SpanEl{elName: "span"}
@@ -1483,13 +1550,13 @@ type StrongEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *StrongEl) GetChilds() []Element   { return e.childs }
 func (e *StrongEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *StrongEl) GetElName() string      { return e.elName }
-func (e *StrongEl) GetElValue() js.Value   { return e.ElValue }
+func (e *StrongEl) GetElValue() Node       { return e.ElValue }
 
 func Strong(attributes ...Attribute) func(...Element) Element {
 	el := &StrongEl{elName: "strong"}
@@ -1500,13 +1567,13 @@ type SubEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SubEl) GetChilds() []Element   { return e.childs }
 func (e *SubEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SubEl) GetElName() string      { return e.elName }
-func (e *SubEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SubEl) GetElValue() Node       { return e.ElValue }
 
 func Sub(attributes ...Attribute) func(...Element) Element {
 	el := &SubEl{elName: "sub"}
@@ -1517,13 +1584,13 @@ type SummaryEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SummaryEl) GetChilds() []Element   { return e.childs }
 func (e *SummaryEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SummaryEl) GetElName() string      { return e.elName }
-func (e *SummaryEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SummaryEl) GetElValue() Node       { return e.ElValue }
 
 func Summary(attributes ...Attribute) func(...Element) Element {
 	el := &SummaryEl{elName: "summary"}
@@ -1534,13 +1601,13 @@ type SupEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *SupEl) GetChilds() []Element   { return e.childs }
 func (e *SupEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *SupEl) GetElName() string      { return e.elName }
-func (e *SupEl) GetElValue() js.Value   { return e.ElValue }
+func (e *SupEl) GetElValue() Node       { return e.ElValue }
 
 func Sup(attributes ...Attribute) func(...Element) Element {
 	el := &SupEl{elName: "sup"}
@@ -1551,13 +1618,13 @@ type TableEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TableEl) GetChilds() []Element   { return e.childs }
 func (e *TableEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TableEl) GetElName() string      { return e.elName }
-func (e *TableEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TableEl) GetElValue() Node       { return e.ElValue }
 
 func Table(attributes ...Attribute) func(...Element) Element {
 	el := &TableEl{elName: "table"}
@@ -1568,13 +1635,13 @@ type TBodyEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TBodyEl) GetChilds() []Element   { return e.childs }
 func (e *TBodyEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TBodyEl) GetElName() string      { return e.elName }
-func (e *TBodyEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TBodyEl) GetElValue() Node       { return e.ElValue }
 
 func TBody(attributes ...Attribute) func(...Element) Element {
 	el := &TBodyEl{elName: "tbody"}
@@ -1587,13 +1654,13 @@ type TdEl struct {
 	Rowspan int64
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TdEl) GetChilds() []Element   { return e.childs }
 func (e *TdEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TdEl) GetElName() string      { return e.elName }
-func (e *TdEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TdEl) GetElValue() Node       { return e.ElValue }
 
 func Td(attributes ...Attribute) func(...Element) Element {
 	el := &TdEl{elName: "td"}
@@ -1602,21 +1669,23 @@ func Td(attributes ...Attribute) func(...Element) Element {
 
 type TextareaEl struct {
 	BasicElement
-	Name     string
-	Cols     int64
-	Rows     int64
-	Disabled bool
-	Readonly bool
-	Required bool
-	Value    string
-	elName   string
-	ElValue  js.Value
+	Name      string
+	Cols      int64
+	Rows      int64
+	Disabled  bool
+	Readonly  bool
+	Required  bool
+	MinLength int64
+	MaxLength int64
+	Value     string
+	elName    string
+	ElValue   Node
 }
 
 func (e *TextareaEl) GetChilds() []Element   { return []Element{} }
 func (e *TextareaEl) AppendChild(el Element) {}
 func (e *TextareaEl) GetElName() string      { return e.elName }
-func (e *TextareaEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TextareaEl) GetElValue() Node       { return e.ElValue }
 
 func Textarea(attributes ...Attribute) func(...Element) Element {
 	el := &TextareaEl{elName: "textarea"}
@@ -1627,13 +1696,13 @@ type TFootEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TFootEl) GetChilds() []Element   { return e.childs }
 func (e *TFootEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TFootEl) GetElName() string      { return e.elName }
-func (e *TFootEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TFootEl) GetElValue() Node       { return e.ElValue }
 
 func TFoot(attributes ...Attribute) func(...Element) Element {
 	el := &TFootEl{elName: "tfoot"}
@@ -1647,13 +1716,13 @@ type ThEl struct {
 	Scope   string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *ThEl) GetChilds() []Element   { return e.childs }
 func (e *ThEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ThEl) GetElName() string      { return e.elName }
-func (e *ThEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ThEl) GetElValue() Node       { return e.ElValue }
 
 func Th(attributes ...Attribute) func(...Element) Element {
 	el := &ThEl{elName: "th"}
@@ -1664,13 +1733,13 @@ type TheadEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TheadEl) GetChilds() []Element   { return e.childs }
 func (e *TheadEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TheadEl) GetElName() string      { return e.elName }
-func (e *TheadEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TheadEl) GetElValue() Node       { return e.ElValue }
 
 func Thead(attributes ...Attribute) func(...Element) Element {
 	el := &TheadEl{elName: "thead"}
@@ -1682,13 +1751,13 @@ type TimeEl struct {
 	DateTime string
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *TimeEl) GetChilds() []Element   { return e.childs }
 func (e *TimeEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TimeEl) GetElName() string      { return e.elName }
-func (e *TimeEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TimeEl) GetElValue() Node       { return e.ElValue }
 
 func Time(attributes ...Attribute) func(...Element) Element {
 	el := &TimeEl{elName: "time"}
@@ -1699,13 +1768,13 @@ type TrEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TrEl) GetChilds() []Element   { return e.childs }
 func (e *TrEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *TrEl) GetElName() string      { return e.elName }
-func (e *TrEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TrEl) GetElValue() Node       { return e.ElValue }
 
 func Tr(attributes ...Attribute) func(...Element) Element {
 	el := &TrEl{elName: "tr"}
@@ -1720,13 +1789,13 @@ type TrackEl struct {
 	Label   string
 	Default bool
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *TrackEl) GetChilds() []Element   { return []Element{} }
 func (e *TrackEl) AppendChild(el Element) {}
 func (e *TrackEl) GetElName() string      { return e.elName }
-func (e *TrackEl) GetElValue() js.Value   { return e.ElValue }
+func (e *TrackEl) GetElValue() Node       { return e.ElValue }
 
 func Track(attributes ...Attribute) func(...Element) Element {
 	el := &TrackEl{elName: "track"}
@@ -1737,13 +1806,13 @@ type UEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *UEl) GetChilds() []Element   { return e.childs }
 func (e *UEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *UEl) GetElName() string      { return e.elName }
-func (e *UEl) GetElValue() js.Value   { return e.ElValue }
+func (e *UEl) GetElValue() Node       { return e.ElValue }
 
 func U(attributes ...Attribute) func(...Element) Element {
 	el := &UEl{elName: "u"}
@@ -1754,13 +1823,13 @@ type UlEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *UlEl) GetChilds() []Element   { return e.childs }
 func (e *UlEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *UlEl) GetElName() string      { return e.elName }
-func (e *UlEl) GetElValue() js.Value   { return e.ElValue }
+func (e *UlEl) GetElValue() Node       { return e.ElValue }
 
 func Ul(attributes ...Attribute) func(...Element) Element {
 	el := &UlEl{elName: "ul"}
@@ -1771,13 +1840,13 @@ type VarEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *VarEl) GetChilds() []Element   { return e.childs }
 func (e *VarEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *VarEl) GetElName() string      { return e.elName }
-func (e *VarEl) GetElValue() js.Value   { return e.ElValue }
+func (e *VarEl) GetElValue() Node       { return e.ElValue }
 
 func Var(attributes ...Attribute) func(...Element) Element {
 	el := &VarEl{elName: "var"}
@@ -1797,13 +1866,13 @@ type VideoEl struct {
 	Preload  string
 	childs   []Element
 	elName   string
-	ElValue  js.Value
+	ElValue  Node
 }
 
 func (e *VideoEl) GetChilds() []Element   { return e.childs }
 func (e *VideoEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *VideoEl) GetElName() string      { return e.elName }
-func (e *VideoEl) GetElValue() js.Value   { return e.ElValue }
+func (e *VideoEl) GetElValue() Node       { return e.ElValue }
 
 func Video(attributes ...Attribute) func(...Element) Element {
 	el := &VideoEl{elName: "video"}
@@ -1813,13 +1882,13 @@ func Video(attributes ...Attribute) func(...Element) Element {
 type WbrEl struct {
 	BasicElement
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *WbrEl) GetChilds() []Element   { return []Element{} }
 func (e *WbrEl) AppendChild(el Element) {}
 func (e *WbrEl) GetElName() string      { return e.elName }
-func (e *WbrEl) GetElValue() js.Value   { return e.ElValue }
+func (e *WbrEl) GetElValue() Node       { return e.ElValue }
 
 func Wbr(attributes ...Attribute) func(...Element) Element {
 	el := &WbrEl{elName: "wbr"}
@@ -1832,13 +1901,13 @@ type DivEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *DivEl) GetChilds() []Element   { return e.childs }
 func (e *DivEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *DivEl) GetElName() string      { return e.elName }
-func (e *DivEl) GetElValue() js.Value   { return e.ElValue }
+func (e *DivEl) GetElValue() Node       { return e.ElValue }
 
 func Div(attributes ...Attribute) func(...Element) Element {
 	el := &DivEl{elName: "div"}
@@ -1852,13 +1921,13 @@ type PEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *PEl) GetChilds() []Element   { return e.childs }
 func (e *PEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *PEl) GetElName() string      { return e.elName }
-func (e *PEl) GetElValue() js.Value   { return e.ElValue }
+func (e *PEl) GetElValue() Node       { return e.ElValue }
 
 func P(attributes ...Attribute) func(...Element) Element {
 	el := &PEl{elName: "p"}
@@ -1881,13 +1950,13 @@ type ButtonEl struct {
 	FormTarget     string
 	childs         []Element
 	elName         string
-	ElValue        js.Value
+	ElValue        Node
 }
 
 func (e *ButtonEl) GetChilds() []Element   { return e.childs }
 func (e *ButtonEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *ButtonEl) GetElName() string      { return e.elName }
-func (e *ButtonEl) GetElValue() js.Value   { return e.ElValue }
+func (e *ButtonEl) GetElValue() Node       { return e.ElValue }
 
 func Button(attributes ...Attribute) func(...Element) Element {
 	el := &ButtonEl{elName: "button"}
@@ -1902,13 +1971,13 @@ type LabelEl struct {
 	For     string
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *LabelEl) GetChilds() []Element   { return e.childs }
 func (e *LabelEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *LabelEl) GetElName() string      { return e.elName }
-func (e *LabelEl) GetElValue() js.Value   { return e.ElValue }
+func (e *LabelEl) GetElValue() Node       { return e.ElValue }
 
 func Label(attributes ...Attribute) func(...Element) Element {
 	el := &LabelEl{elName: "label"}
@@ -1925,15 +1994,20 @@ type InputEl struct {
 	Value       string
 	Placeholder string
 	Required    bool
+	Min         int64
+	Max         int64
+	MinLength   int64
+	MaxLength   int64
+	Pattern     string
 	childs      []Element
 	elName      string
-	ElValue     js.Value
+	ElValue     Node
 }
 
 func (e *InputEl) GetChilds() []Element   { return e.childs }
 func (e *InputEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *InputEl) GetElName() string      { return e.elName }
-func (e *InputEl) GetElValue() js.Value   { return e.ElValue }
+func (e *InputEl) GetElValue() Node       { return e.ElValue }
 
 func Input(attributes ...Attribute) func(...Element) Element {
 	el := &InputEl{elName: "input"}
@@ -1947,13 +2021,13 @@ type HeaderEl struct {
 	BasicElement
 	childs  []Element
 	elName  string
-	ElValue js.Value
+	ElValue Node
 }
 
 func (e *HeaderEl) GetChilds() []Element   { return e.childs }
 func (e *HeaderEl) AppendChild(el Element) { e.childs = append(e.childs, el) }
 func (e *HeaderEl) GetElName() string      { return e.elName }
-func (e *HeaderEl) GetElValue() js.Value   { return e.ElValue }
+func (e *HeaderEl) GetElValue() Node       { return e.ElValue }
 
 func Header(attributes ...Attribute) func(...Element) Element {
 	el := &HeaderEl{elName: "header"}
@@ -0,0 +1,166 @@
+package elements
+
+import "reflect"
+
+// RawEvent is the event given by the DOM backend to the listeners
+// (e.g. JSEvent in the browser or *MemoryEvent in memory)
+type RawEvent interface {
+	// Get returns the event property at the given path (e.g. "key"
+	// or "target", "value") as a string, float64 or bool, and nil
+	// if the property doesn't exist
+	Get(path ...string) interface{}
+	// Call calls an event method (e.g. "preventDefault")
+	Call(method string)
+}
+
+// EventListener is the listener every event handler is turned into,
+// it receives the event wrapping the backend one
+type EventListener func(Event)
+
+// Event is the base of every typed event
+type Event struct {
+	raw RawEvent
+}
+
+func NewEvent(raw RawEvent) Event { return Event{raw: raw} }
+
+// Raw returns the backend event (e.g. JSEvent in the browser)
+func (e Event) Raw() RawEvent { return e.raw }
+
+func (e Event) Type() string { return e.string("type") }
+
+// PreventDefault cancels the browser default action
+// (e.g. the navigation when a form is submitted)
+func (e Event) PreventDefault() { e.raw.Call("preventDefault") }
+
+// StopPropagation stops the event from reaching the parents
+func (e Event) StopPropagation() { e.raw.Call("stopPropagation") }
+
+// Target returns the element on which the event happened
+func (e Event) Target() EventTarget { return EventTarget{raw: e.raw} }
+
+func (e Event) string(path ...string) string {
+	v, _ := e.raw.Get(path...).(string)
+	return v
+}
+
+func (e Event) float(path ...string) float64 {
+	v, _ := e.raw.Get(path...).(float64)
+	return v
+}
+
+func (e Event) bool(path ...string) bool {
+	v, _ := e.raw.Get(path...).(bool)
+	return v
+}
+
+// EventTarget is the element on which an event happened
+type EventTarget struct {
+	raw RawEvent
+}
+
+func (t EventTarget) event() Event { return Event{raw: t.raw} }
+
+func (t EventTarget) ID() string      { return t.event().string("target", "id") }
+func (t EventTarget) Name() string    { return t.event().string("target", "name") }
+func (t EventTarget) Value() string   { return t.event().string("target", "value") }
+func (t EventTarget) Checked() bool   { return t.event().bool("target", "checked") }
+func (t EventTarget) TagName() string { return t.event().string("target", "tagName") }
+
+// MouseEvent is given to mouse events handlers (e.g. OnClick)
+type MouseEvent struct {
+	Event
+}
+
+func (e MouseEvent) ClientX() float64 { return e.float("clientX") }
+func (e MouseEvent) ClientY() float64 { return e.float("clientY") }
+func (e MouseEvent) PageX() float64   { return e.float("pageX") }
+func (e MouseEvent) PageY() float64   { return e.float("pageY") }
+func (e MouseEvent) Button() int      { return int(e.float("button")) }
+func (e MouseEvent) AltKey() bool     { return e.bool("altKey") }
+func (e MouseEvent) CtrlKey() bool    { return e.bool("ctrlKey") }
+func (e MouseEvent) MetaKey() bool    { return e.bool("metaKey") }
+func (e MouseEvent) ShiftKey() bool   { return e.bool("shiftKey") }
+
+// KeyboardEvent is given to keyboard events handlers (e.g. OnKeyDown)
+type KeyboardEvent struct {
+	Event
+}
+
+// Key returns the key value (e.g. "a", "Enter", "ArrowUp")
+func (e KeyboardEvent) Key() string { return e.string("key") }
+
+// Code returns the physical key code (e.g. "KeyA", "Enter")
+func (e KeyboardEvent) Code() string   { return e.string("code") }
+func (e KeyboardEvent) Repeat() bool   { return e.bool("repeat") }
+func (e KeyboardEvent) AltKey() bool   { return e.bool("altKey") }
+func (e KeyboardEvent) CtrlKey() bool  { return e.bool("ctrlKey") }
+func (e KeyboardEvent) MetaKey() bool  { return e.bool("metaKey") }
+func (e KeyboardEvent) ShiftKey() bool { return e.bool("shiftKey") }
+
+// InputEvent is given to input events handlers (e.g. OnInput, OnChange)
+type InputEvent struct {
+	Event
+}
+
+// Value returns the new value of the input
+func (e InputEvent) Value() string { return e.Target().Value() }
+
+// Checked returns the new checked state of a checkbox or radio input
+func (e InputEvent) Checked() bool { return e.Target().Checked() }
+
+// Data returns the inserted characters, if any
+func (e InputEvent) Data() string { return e.string("data") }
+
+// SubmitEvent is given to form submit handlers (e.g. OnSubmit)
+//
+// NOTE: call PreventDefault to stop the browser navigation
+type SubmitEvent struct {
+	Event
+}
+
+// ---------------- Handlers ----->
+
+// Handler is an event handler with or without the event
+type Handler interface{ ~func() | ~func(Event) }
+
+// MouseHandler is a mouse event handler with or without the event
+type MouseHandler interface{ ~func() | ~func(MouseEvent) }
+
+// KeyboardHandler is a keyboard event handler with or without the event
+type KeyboardHandler interface{ ~func() | ~func(KeyboardEvent) }
+
+// InputHandler is an input event handler with or without the event
+type InputHandler interface{ ~func() | ~func(InputEvent) }
+
+// SubmitHandler is a submit event handler with or without the event
+type SubmitHandler interface{ ~func() | ~func(SubmitEvent) }
+
+// toListener turns an event handler into an event listener, wrap
+// creates the typed event given to the handler
+func toListener[E any](handler interface{}, wrap func(Event) E) EventListener {
+	switch h := handler.(type) {
+	case func():
+		return func(Event) { h() }
+	case EventHandler:
+		return func(Event) { h() }
+	case func(E):
+		return func(e Event) { h(wrap(e)) }
+	}
+
+	// named handler types
+	fn := reflect.ValueOf(handler)
+	if fn.Type().NumIn() == 0 {
+		return func(Event) { fn.Call(nil) }
+	}
+
+	return func(e Event) {
+		fn.Call([]reflect.Value{reflect.ValueOf(wrap(e))})
+	}
+}
+
+func plainEvent(e Event) Event            { return e }
+func mouseEvent(e Event) MouseEvent       { return MouseEvent{e} }
+func keyboardEvent(e Event) KeyboardEvent { return KeyboardEvent{e} }
+func inputEvent(e Event) InputEvent       { return InputEvent{e} }
+func submitEvent(e Event) SubmitEvent     { return SubmitEvent{e} }
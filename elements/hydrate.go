@@ -0,0 +1,246 @@
+package elements
+
+import "log"
+
+// DevMode enables the development checks, e.g. the
+// hydration mismatches are logged
+var DevMode = false
+
+// Hydrate adopts the nodes already in parent (e.g. a page prerendered
+// with Render) as the built element tree instead of creating them,
+// the listeners are attached and the ElValue fields are set, when a
+// node doesn't match its element it's rebuilt (and logged in DevMode)
+//
+// NOTE: the nodes must be the ones Render gives for the same tree
+func Hydrate(element Element, parent Node) Node {
+	defer runLifecycle()
+
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	h := &hydrator{nodes: dom.ChildNodes(parent)}
+
+	v := h.hydrateElement(element, parent)
+	if node := v.domNode(); node != nil {
+		return node
+	}
+
+	return parent
+}
+
+// hydrator walks the nodes of a container
+// while their elements are hydrated
+type hydrator struct {
+	nodes []Node
+	index int
+}
+
+// current returns the node at the cursor, or nil at the end
+func (h *hydrator) current() Node {
+	if h.index < len(h.nodes) {
+		return h.nodes[h.index]
+	}
+
+	return nil
+}
+
+// nextText returns the text node at the cursor, or nil if the next
+// node is not a text node (e.g. the text was merged with the previous
+// one or it's empty and the next node is a fragment anchor)
+func (h *hydrator) nextText() Node {
+	if node := h.current(); node != nil && dom.NodeName(node) == "#text" {
+		return node
+	}
+
+	return nil
+}
+
+// anchor returns the fragment anchor at the cursor, the anchor
+// is created if the html doesn't have it (e.g. not written by Render)
+func (h *hydrator) anchor(parent Node, text string) Node {
+	for i := h.index; i < len(h.nodes); i++ {
+		name := dom.NodeName(h.nodes[i])
+		if name == "#comment" {
+			h.index = i + 1
+			return h.nodes[i]
+		}
+
+		if name != "#text" {
+			break
+		}
+	}
+
+	if DevMode {
+		log.Printf("hydration mismatch: fragment anchor not found")
+	}
+
+	node := dom.CreateComment(text)
+	dom.InsertBefore(parent, node, h.current())
+
+	return node
+}
+
+// next returns the next element node, the text nodes left
+// between elements (e.g. indentation) are skipped
+func (h *hydrator) next() Node {
+	for ; h.index < len(h.nodes); h.index++ {
+		switch dom.NodeName(h.nodes[h.index]) {
+		case "#text", "#comment", "script":
+
+		default:
+			return h.nodes[h.index]
+		}
+	}
+
+	return nil
+}
+
+func (h *hydrator) hydrateElement(elem Element, parent Node) *vnode {
+	switch el := elem.(type) {
+	case *TextEl:
+		node := h.nextText()
+		if node == nil {
+			// e.g. an empty text isn't rendered, it's
+			// created before the next node or anchor
+			return buildElement(elem, parent, h.current())
+		}
+		h.index++
+
+		v := &vnode{
+			el:         elem,
+			name:       elem.GetElName(),
+			key:        elementKey(elem),
+			node:       node,
+			parentNode: parent,
+			text:       el.InnerText,
+		}
+		vnodes[elem] = v
+
+		// the texts next to each other are in the same node
+		// in the html, the next ones are created after it
+		dom.SetNodeValue(node, el.InnerText)
+
+		el.ElValue = node
+
+		return v
+
+	case *EmptyEl:
+		v := &vnode{
+			el:         elem,
+			name:       elem.GetElName(),
+			key:        elementKey(elem),
+			parentNode: parent,
+		}
+		vnodes[elem] = v
+
+		return v
+
+	case *SliceEl:
+		// the slice childs are already in the parent
+		// between the anchors written by Render
+		v := &vnode{
+			el:         elem,
+			name:       elem.GetElName(),
+			key:        elementKey(elem),
+			parentNode: parent,
+			fragment:   true,
+		}
+		vnodes[elem] = v
+
+		v.start = h.anchor(parent, fragmentStart)
+		v.children = h.hydrateChildren(v, el.GetChilds(), parent)
+		v.end = h.anchor(parent, fragmentEnd)
+
+		el.ElValue = v.start
+
+		return v
+
+	case *DynamicEl:
+		v := &vnode{
+			el:          elem,
+			name:        elem.GetElName(),
+			key:         elementKey(elem),
+			parentNode:  parent,
+			transparent: true,
+		}
+		vnodes[elem] = v
+
+		v.children = h.hydrateChildren(v, []Element{el.render()}, parent)
+
+		el.ElValue = v.children[0].domNode()
+
+		if el.Mounted != nil {
+			lifecycle = append(lifecycle, el.Mounted)
+		}
+
+		return v
+	}
+
+	node := h.next()
+	if node == nil || dom.NodeName(node) != elem.GetElName() {
+		if DevMode {
+			found := "nothing"
+			if node != nil {
+				found = "<" + dom.NodeName(node) + ">"
+			}
+
+			log.Printf("hydration mismatch: expected <%s>, found %s", elem.GetElName(), found)
+		}
+
+		// the element is built before the node, which
+		// may match one of the next elements
+		return buildElement(elem, parent, node)
+	}
+	h.index++
+
+	v := &vnode{
+		el:         elem,
+		name:       elem.GetElName(),
+		key:        elementKey(elem),
+		node:       node,
+		parentNode: parent,
+	}
+	vnodes[elem] = v
+
+	// the attributes are set again, it's cheap and
+	// the vnode snapshot then matches the dom
+	buildElementAttributes(v, elem)
+	buildElementStyles(v, elem)
+
+	if textarea, ok := elem.(*TextareaEl); ok {
+		v.text = textarea.Value
+	}
+
+	children := &hydrator{nodes: dom.ChildNodes(node)}
+	v.children = children.hydrateChildren(v, elem.GetChilds(), node)
+
+	// the nodes left are not in the element tree
+	for node := children.next(); node != nil; node = children.next() {
+		if DevMode {
+			log.Printf(
+				"hydration mismatch: unexpected <%s> in <%s>",
+				dom.NodeName(node), elem.GetElName(),
+			)
+		}
+
+		dom.RemoveChild(v.node, node)
+		children.index++
+	}
+
+	buildElementBinding(v, elem)
+
+	setElValue(elem, node)
+
+	return v
+}
+
+func (h *hydrator) hydrateChildren(parent *vnode, elems []Element, container Node) []*vnode {
+	children := make([]*vnode, len(elems))
+
+	for i, child := range elems {
+		children[i] = h.hydrateElement(child, container)
+		children[i].parent = parent
+	}
+
+	return children
+}
@@ -0,0 +1,211 @@
+package elements
+
+import "testing"
+
+// prerender returns the body of a new memory backend with the nodes a
+// browser gives for the html of el, like a page rendered on the server
+func prerender(t *testing.T, el Element) *MemoryNode {
+	t.Helper()
+
+	body := mount(t, el)
+	parsed(body)
+
+	return body
+}
+
+// parsed removes what the html doesn't keep from the built nodes, the
+// listeners, the properties and the empty texts, and merges the texts
+// next to each other like the browsers do when they parse the html
+func parsed(n *MemoryNode) {
+	n.listeners = nil
+	n.Properties = nil
+	n.Styles = nil
+
+	children := []*MemoryNode{}
+	for _, child := range n.Children {
+		if child.Tag == "#text" {
+			if child.Text == "" {
+				continue
+			}
+
+			if last := len(children) - 1; last >= 0 && children[last].Tag == "#text" {
+				children[last].Text += child.Text
+				continue
+			}
+		}
+
+		parsed(child)
+		children = append(children, child)
+	}
+
+	n.Children = children
+}
+
+// memoryNodes returns every node under n
+func memoryNodes(n *MemoryNode) []*MemoryNode {
+	nodes := []*MemoryNode{}
+	for _, child := range n.Children {
+		nodes = append(nodes, child)
+		nodes = append(nodes, memoryNodes(child)...)
+	}
+
+	return nodes
+}
+
+func TestHydrate(t *testing.T) {
+	tests := []struct {
+		name string
+		el   func() Element
+	}{
+		{
+			name: "elements and attributes",
+			el: func() Element {
+				return Div(ID("app"), Class("a"))(H1()(Text("title")), P()(Text("text")))
+			},
+		},
+		{
+			name: "texts next to each other",
+			el: func() Element {
+				return P()(Text("hello "), Text("bob"), Strong()(Text("!")))
+			},
+		},
+		{
+			name: "empty text",
+			el: func() Element {
+				return P()(Text(""), Span()(Text("a")))
+			},
+		},
+		{
+			name: "fragment",
+			el: func() Element {
+				return Ul()(Fragment(Li()(Text("a")), Li()(Text("b"))), Li()(Text("c")))
+			},
+		},
+		{
+			name: "texts next to a fragment",
+			el: func() Element {
+				return Div()(Text("hello "), Text("bob"), Fragment(Span()(Text("z"))), Text("!"))
+			},
+		},
+		{
+			name: "empty text before a fragment",
+			el: func() Element {
+				return Div()(Text(""), Fragment(Span()(Text("z"))))
+			},
+		},
+		{
+			name: "texts in a fragment",
+			el: func() Element {
+				return Div()(Fragment(Text("a"), Text("b")), Fragment(), Text("c"))
+			},
+		},
+		{
+			name: "dynamic element",
+			el: func() Element {
+				return Div()(Dynamic(func() Element { return Span()(Text("a")) }))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := prerender(t, test.el())
+			want := memoryHTML(body)
+			nodes := memoryNodes(body)
+
+			Hydrate(test.el(), body)
+
+			if got := memoryHTML(body); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+
+			// the server-rendered nodes must be adopted, not rebuilt,
+			// the texts merged by the html are created again
+			hydrated := make(map[*MemoryNode]bool)
+			for _, node := range memoryNodes(body) {
+				hydrated[node] = true
+			}
+
+			for _, node := range nodes {
+				if !hydrated[node] {
+					t.Errorf("<%s> %q was rebuilt", node.Tag, node.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestHydrateEvents(t *testing.T) {
+	el := func(clicks *int) Element {
+		return Button(OnClick(func() { *clicks++ }))(Text("+"))
+	}
+
+	var clicks int
+	body := prerender(t, el(&clicks))
+	button := body.Children[0]
+
+	hydrated := el(&clicks)
+	Hydrate(hydrated, body)
+
+	button.Dispatch("click")
+	if clicks != 1 {
+		t.Errorf("got %d clicks, want 1", clicks)
+	}
+
+	if hydrated.GetElValue() != button {
+		t.Error("the ElValue of the element is not the server-rendered node")
+	}
+}
+
+func TestHydratePatch(t *testing.T) {
+	body := prerender(t, Ul()(Li()(Text("a"))))
+	ul := body.Children[0]
+
+	before := Ul()(Li()(Text("a")))
+	Hydrate(before, body)
+	Patch(before, Ul()(Li()(Text("a")), Li()(Text("b"))))
+
+	if got, want := memoryHTML(body), `<ul><li>a</li><li>b</li></ul>`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if body.Children[0] != ul {
+		t.Error("the hydrated list was rebuilt")
+	}
+}
+
+func TestHydrateMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		server Element
+		client Element
+	}{
+		{
+			name:   "another element",
+			server: Div()(Span()(Text("a"))),
+			client: Div()(P()(Text("a"))),
+		},
+		{
+			name:   "missing element",
+			server: Div()(P()(Text("a"))),
+			client: Div()(P()(Text("a")), P()(Text("b"))),
+		},
+		{
+			name:   "unexpected element",
+			server: Div()(P()(Text("a")), P()(Text("b"))),
+			client: Div()(P()(Text("a"))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want := memoryHTML(mount(t, test.client))
+
+			body := prerender(t, test.server)
+			Hydrate(test.client, body)
+
+			if got := memoryHTML(body); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package elements
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+)
+
+// void elements can't have childs and must not be closed
+// (e.g. <br>, <img src="...">, <input type="text">)
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// Render writes the html representation of the given element tree
+// into w, it walks the same tree as the dom builder so it can be
+// used to serve pages from a normal go http server or to test views
+// without a browser
+func Render(el Element, w io.Writer) error {
+	r := &renderer{w: w}
+
+	r.element(el)
+
+	return r.err
+}
+
+// renderer keeps the first write error so the tree walk
+// doesn't have to check every single write
+type renderer struct {
+	w   io.Writer
+	err error
+	// selected are the bound values of the select being
+	// rendered, its options are selected by value
+	selected map[string]bool
+}
+
+func (r *renderer) write(s string) {
+	if r.err != nil {
+		return
+	}
+
+	_, r.err = io.WriteString(r.w, s)
+}
+
+func (r *renderer) element(elem Element) {
+	switch el := elem.(type) {
+	case *TextEl:
+		r.write(html.EscapeString(el.InnerText))
+
+	case *EmptyEl:
+		return
+
+	case *SliceEl:
+		// the anchors let the hydration find the fragment childs
+		r.write("<!--" + fragmentStart + "-->")
+		for _, child := range el.GetChilds() {
+			r.element(child)
+		}
+		r.write("<!--" + fragmentEnd + "-->")
+
+	case *DynamicEl:
+		// dynamics are not real elements, just render the child
+		for _, child := range el.GetChilds() {
+			r.element(child)
+		}
+
+	default:
+		name := el.GetElName()
+
+		r.write("<" + name)
+		r.attributes(el)
+		r.write(">")
+
+		if voidElements[name] {
+			return
+		}
+
+		// textarea value is the element content in html
+		if textarea, ok := el.(*TextareaEl); ok {
+			value := textarea.Value
+			if binding := elementBinding(el); binding != nil && binding.Property == "value" {
+				value, _ = binding.Value.(string)
+			}
+
+			r.write(html.EscapeString(value))
+		}
+
+		// the value of a bound select is given by its options
+		if _, ok := el.(*SelectEl); ok {
+			if binding := elementBinding(el); binding != nil {
+				selected := r.selected
+				r.selected = bindingValues(binding)
+				defer func() { r.selected = selected }()
+			}
+		}
+
+		for _, child := range el.GetChilds() {
+			r.element(child)
+		}
+
+		r.write("</" + name + ">")
+	}
+}
+
+// bindingValues returns the selected values of a bound select
+func bindingValues(binding *Binding) map[string]bool {
+	selected := make(map[string]bool)
+
+	switch value := binding.Value.(type) {
+	case string:
+		selected[value] = true
+
+	case []string:
+		for _, v := range value {
+			selected[v] = true
+		}
+	}
+
+	return selected
+}
+
+// optionValue returns the value of the option, its text by default
+func optionValue(option *OptionEl) string {
+	if option.Value != "" {
+		return option.Value
+	}
+
+	var b strings.Builder
+	for _, child := range option.GetChilds() {
+		if text, ok := child.(*TextEl); ok {
+			b.WriteString(text.InnerText)
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func (r *renderer) attributes(elem Element) {
+	attributes, _ := elementAttributes(elem)
+
+	// the css properties are written in the style attribute
+	if styles := elementStyles(elem); len(styles) > 0 {
+		attributes = mergeStyles(attributes, styles)
+	}
+
+	// the options of a bound select are selected by its value
+	if option, ok := elem.(*OptionEl); ok && r.selected != nil {
+		attributes = selectOption(attributes, r.selected[optionValue(option)])
+	}
+
+	for _, attr := range attributes {
+		// boolean attributes are present or not
+		if attr.boolean {
+			r.write(" " + attr.name)
+			continue
+		}
+
+		r.write(fmt.Sprintf(
+			` %s="%s"`,
+			attr.name,
+			html.EscapeString(attr.value),
+		))
+	}
+
+	// the bound property is written as its attribute, the
+	// value of a select is written on its options
+	binding := elementBinding(elem)
+	if binding == nil {
+		return
+	}
+
+	if _, ok := elem.(*SelectEl); ok {
+		return
+	}
+
+	switch value := binding.Value.(type) {
+	case string:
+		if _, ok := elem.(*TextareaEl); !ok {
+			r.write(fmt.Sprintf(` %s="%s"`, binding.Property, html.EscapeString(value)))
+		}
+
+	case bool:
+		if value {
+			r.write(" " + binding.Property)
+		}
+	}
+}
+
+// selectOption replaces the selected attribute of an option
+func selectOption(attributes []attribute, selected bool) []attribute {
+	options := make([]attribute, 0, len(attributes)+1)
+	for _, attr := range attributes {
+		if attr.name != "selected" {
+			options = append(options, attr)
+		}
+	}
+
+	if selected {
+		options = append(options, attribute{name: "selected", boolean: true})
+		sort.Slice(options, func(i, j int) bool {
+			return options[i].name < options[j].name
+		})
+	}
+
+	return options
+}
+
+// mergeStyles appends the styles to the style attribute
+func mergeStyles(attributes []attribute, styles Styles) []attribute {
+	for i, attr := range attributes {
+		if attr.name == "style" {
+			value := strings.TrimSpace(attr.value)
+			if value != "" && !strings.HasSuffix(value, ";") {
+				value += ";"
+			}
+
+			attributes[i].value = strings.TrimSpace(value + " " + styles.String())
+			return attributes
+		}
+	}
+
+	attributes = append(attributes, attribute{name: "style", value: styles.String()})
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].name < attributes[j].name
+	})
+
+	return attributes
+}
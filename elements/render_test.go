@@ -0,0 +1,106 @@
+package elements
+
+import (
+	"strings"
+	"testing"
+)
+
+// render returns the html of the element or fails the test
+func render(t *testing.T, el Element) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := Render(el, &b); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	return b.String()
+}
+
+// bound returns a binding of the property to the value
+func bound(property string, value interface{}) Attribute {
+	return Attribute{Name: "Bind", Value: &Binding{Property: property, Value: value}}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		el   Element
+		want string
+	}{
+		{
+			name: "text is escaped",
+			el:   P()(Text(`<b> & "quotes"`)),
+			want: `<p>&lt;b&gt; &amp; &#34;quotes&#34;</p>`,
+		},
+		{
+			name: "attributes are sorted and escaped",
+			el:   A(Href("/a?b=1&c=2"), ID("link"), Class("x"))(Text("a")),
+			want: `<a class="x" href="/a?b=1&amp;c=2" id="link">a</a>`,
+		},
+		{
+			name: "boolean attributes have no value",
+			el:   Button(Disabled)(Text("ok")),
+			want: `<button disabled>ok</button>`,
+		},
+		{
+			name: "data and aria attributes",
+			el:   Div(Data("id", "1"), Aria("label", "box"))(),
+			want: `<div aria-label="box" data-id="1"></div>`,
+		},
+		{
+			name: "void elements are not closed",
+			el:   Div()(Img(Src("/a.png"))(), Br()),
+			want: `<div><img src="/a.png"><br></div>`,
+		},
+		{
+			name: "empty elements are not rendered",
+			el:   Div()(&EmptyEl{}, Text("a")),
+			want: `<div>a</div>`,
+		},
+		{
+			name: "nested childs",
+			el:   Ul()(Li()(Text("a")), Li()(Text("b"))),
+			want: `<ul><li>a</li><li>b</li></ul>`,
+		},
+		{
+			name: "required select",
+			el:   Select(Name("color"), Required)(Option(Value("red"))(Text("red"))),
+			want: `<select name="color" required><option value="red">red</option></select>`,
+		},
+		{
+			name: "bound input value",
+			el:   Input(bound("value", "a&b"))(),
+			want: `<input value="a&amp;b">`,
+		},
+		{
+			name: "bound select selects its option",
+			el: Select(bound("value", "b"))(
+				Option(Value("a"), Selected)(Text("A")),
+				Option(Value("b"))(Text("B")),
+			),
+			want: `<select><option value="a">A</option><option selected value="b">B</option></select>`,
+		},
+		{
+			name: "bound multiple select selects its options by text",
+			el: Select(Multiple, bound(SelectedValues, []string{"a", "c"}))(
+				Option()(Text("a")),
+				OptGroup()(Option()(Text("b")), Option()(Text("c"))),
+			),
+			want: `<select multiple><option selected>a</option><optgroup><option>b</option><option selected>c</option></optgroup></select>`,
+		},
+		{
+			name: "fragments are written between anchors",
+			el:   Dl()(Fragment(Dt()(Text("a")), Dd()(Text("b")))),
+			want: `<dl><!--[--><dt>a</dt><dd>b</dd><!--]--></dl>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := render(t, test.el); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
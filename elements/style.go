@@ -0,0 +1,101 @@
+package elements
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Styles are css properties set one by one with style.setProperty
+// instead of replacing the whole style attribute, by property name,
+// they are merged with the Style attribute (see the css package)
+type Styles map[string]StyleValue
+
+// StyleValue is the value of a css property
+type StyleValue struct {
+	Value string
+	// Watch is called once the property is set with a function changing
+	// its value (e.g. when a state changes), it returns a function
+	// stopping the watch, called when the element is patched or removed
+	//
+	// NOTE: set only changes the dom node, it can be called anytime
+	Watch func(set func(value string)) (stop func())
+}
+
+// String returns the declarations of the styles
+// sorted by property name (e.g. "color: red; padding: 0;")
+func (s Styles) String() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	declarations := make([]string, len(names))
+	for i, name := range names {
+		declarations[i] = name + ": " + s[name].Value + ";"
+	}
+
+	return strings.Join(declarations, " ")
+}
+
+// elementStyles returns the styles of the element, if any
+func elementStyles(elem Element) Styles {
+	field := reflect.ValueOf(elem).Elem().FieldByName("Styles")
+	if !field.IsValid() {
+		return nil
+	}
+
+	styles, _ := field.Interface().(Styles)
+	return styles
+}
+
+// buildElementStyles sets the changed properties and removes the
+// old ones, it's called after buildElementAttributes as setting
+// the style attribute removes the properties
+func buildElementStyles(v *vnode, elem Element) {
+	styles := elementStyles(elem)
+
+	stopStyleWatches(v)
+
+	for name := range v.styles {
+		if _, ok := styles[name]; !ok {
+			dom.RemoveStyleProperty(v.node, name)
+			delete(v.styles, name)
+		}
+	}
+
+	if v.styles == nil && len(styles) > 0 {
+		v.styles = make(map[string]string, len(styles))
+	}
+
+	for name, style := range styles {
+		// a watched property may have been changed since it was set
+		if current, ok := v.styles[name]; !ok || current != style.Value || style.Watch != nil {
+			dom.SetStyleProperty(v.node, name, style.Value)
+			v.styles[name] = style.Value
+		}
+
+		if style.Watch == nil {
+			continue
+		}
+
+		if v.styleWatches == nil {
+			v.styleWatches = make(map[string]func())
+		}
+
+		node, name := v.node, name
+		v.styleWatches[name] = style.Watch(func(value string) {
+			dom.SetStyleProperty(node, name, value)
+		})
+	}
+}
+
+func stopStyleWatches(v *vnode) {
+	for name, stop := range v.styleWatches {
+		if stop != nil {
+			stop()
+		}
+		delete(v.styleWatches, name)
+	}
+}
@@ -3,6 +3,7 @@ package elements
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 )
 
 func hasField(
@@ -73,9 +74,42 @@ func setValue(
 			return nil
 		}
 
-	case reflect.Func:
-		if v, ok := value.(EventHandler); ok {
-			fieldVal.Set(reflect.ValueOf(v))
+	case reflect.Map:
+		// data-* and aria-* attributes are merged so
+		// they can be given several times
+		if v, ok := value.(map[string]interface{}); ok {
+			if fieldVal.IsNil() {
+				fieldVal.Set(reflect.ValueOf(map[string]interface{}{}))
+			}
+
+			for key, val := range v {
+				fieldVal.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+			}
+			return nil
+		}
+
+		// other maps (e.g. Styles) are merged the same way
+		if v := reflect.ValueOf(value); v.IsValid() && v.Type().AssignableTo(fieldVal.Type()) {
+			if fieldVal.IsNil() {
+				fieldVal.Set(reflect.MakeMap(fieldVal.Type()))
+			}
+
+			iter := v.MapRange()
+			for iter.Next() {
+				fieldVal.SetMapIndex(iter.Key(), iter.Value())
+			}
+			return nil
+		}
+
+	case reflect.Interface:
+		if value != nil {
+			fieldVal.Set(reflect.ValueOf(value))
+			return nil
+		}
+
+	case reflect.Func, reflect.Ptr:
+		if v := reflect.ValueOf(value); v.IsValid() && v.Type().AssignableTo(fieldVal.Type()) {
+			fieldVal.Set(v)
 			return nil
 		}
 
@@ -86,6 +120,54 @@ func setValue(
 	return fmt.Errorf("unsupported field type")
 }
 
+// SetValueString parses the text into the value kind (string, bool,
+// integers and floats) and sets it, an empty text sets the zero value,
+// it's used to decode the values of the inputs (e.g. see forms.For)
+func SetValueString(fieldVal reflect.Value, value string) error {
+	if value == "" {
+		fieldVal.Set(reflect.Zero(fieldVal.Type()))
+		return nil
+	}
+
+	switch fieldVal.Kind() {
+	case reflect.String:
+		fieldVal.SetString(value)
+
+	case reflect.Bool:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		fieldVal.SetBool(v)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(value, 10, fieldVal.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldVal.SetInt(v)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(value, 10, fieldVal.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldVal.SetUint(v)
+
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(value, fieldVal.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldVal.SetFloat(v)
+
+	default:
+		return fmt.Errorf("unsupported field type %s", fieldVal.Type())
+	}
+
+	return nil
+}
+
 func fieldsToMap(s interface{}) map[string]interface{} {
 	fields := make(map[string]interface{})
 	value := reflect.ValueOf(s).Elem()
@@ -95,7 +177,8 @@ func fieldsToMap(s interface{}) map[string]interface{} {
 	addFields = func(v reflect.Value, t reflect.Type) {
 		for i := 0; i < t.NumField(); i++ {
 			field := t.Field(i)
-			if field.PkgPath != "" {
+			// ElValue is the node built by the backend
+			if field.PkgPath != "" || field.Name == "ElValue" {
 				continue
 			}
 
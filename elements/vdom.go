@@ -0,0 +1,532 @@
+package elements
+
+import (
+	"log"
+	"reflect"
+	"sync"
+)
+
+// vnode is the snapshot of a built element, it's kept so the next
+// Update can diff the element tree against what is in the dom and
+// only apply the needed patches instead of rebuilding everything
+type vnode struct {
+	el   Element
+	name string
+	key  interface{}
+	// node is nil for empty elements
+	node       Node
+	parentNode Node
+	parent     *vnode
+	// slices are fragments, their childs are in their parent
+	// container between the start and end comments anchors
+	fragment   bool
+	start, end Node
+	// dynamic elements are not in the dom, their
+	// child is in their parent container instead
+	transparent bool
+	// text of text elements and textarea value
+	text       string
+	attributes map[string]attribute
+	// css properties set in the node and the
+	// functions stopping the watched ones
+	styles       map[string]string
+	styleWatches map[string]func()
+	events       map[string]EventListener
+	// functions removing the dom listeners by event
+	listeners map[string]func()
+	// binding of the element, the function removing its
+	// listener and the one stopping its watch
+	binding       *Binding
+	removeBinding func()
+	stopBinding   func()
+	children      []*vnode
+}
+
+// every built element vnode, so Update can find the
+// previous snapshot of the element it is given
+var (
+	vnodes      = make(map[Element]*vnode)
+	vnodesMutex sync.Mutex
+	// lifecycle hooks called once the dom is patched
+	lifecycle []func()
+	// patching is the number of running patches and afterPatch the
+	// functions waiting for them to end, see AfterPatch
+	patching      int
+	afterPatch    []func()
+	patchingMutex sync.Mutex
+)
+
+// lockPatch locks the vnodes for a patch, the patch
+// ends with the runLifecycle deferred before
+func lockPatch() {
+	vnodesMutex.Lock()
+
+	patchingMutex.Lock()
+	patching++
+	patchingMutex.Unlock()
+}
+
+// runLifecycle calls the queued lifecycle hooks, it's called when
+// the dom is patched and the vnodes mutex is unlocked, so the hooks
+// can update elements, the functions given to AfterPatch while
+// the patch was running are called after
+func runLifecycle() {
+	patchingMutex.Lock()
+	patching--
+	var after []func()
+	if patching == 0 {
+		after = afterPatch
+		afterPatch = nil
+	}
+	patchingMutex.Unlock()
+
+	vnodesMutex.Lock()
+	hooks := lifecycle
+	lifecycle = nil
+	vnodesMutex.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+
+	for _, fn := range after {
+		fn()
+	}
+}
+
+// AfterPatch calls fn once the running patches (Mount, Update,
+// Patch...) are done, or right away if there is none, so fn can
+// update elements even if it's called while an element is
+// rendered (e.g. a state set by a component)
+func AfterPatch(fn func()) {
+	patchingMutex.Lock()
+	if patching > 0 {
+		afterPatch = append(afterPatch, fn)
+		patchingMutex.Unlock()
+		return
+	}
+	patchingMutex.Unlock()
+
+	fn()
+}
+
+// Update diffs the element tree against the previously built one
+// and patches the dom with the minimal changes (attributes, text,
+// insert and remove of childs), so focus, scroll position and
+// inputs contents of the untouched nodes are kept
+func Update(e Element) {
+	defer runLifecycle()
+
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	v, ok := vnodes[e]
+	if !ok {
+		log.Printf("can't update %s element, it is not built", e.GetElName())
+		return
+	}
+
+	patchElement(v, e)
+}
+
+// Patch diffs the new element tree against the built old one and
+// patches the dom, the new element takes the old one place
+func Patch(old, new Element) {
+	defer runLifecycle()
+
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	v, ok := vnodes[old]
+	if !ok {
+		log.Printf("can't patch %s element, it is not built", old.GetElName())
+		return
+	}
+
+	if v.parent != nil {
+		// patch the parent childs so text, keys
+		// and siblings are handled as usual
+		elems := make([]Element, len(v.parent.children))
+		for i, child := range v.parent.children {
+			elems[i] = child.el
+			if child == v {
+				elems[i] = new
+			}
+		}
+
+		v.parent.children = patchChildren(v.parent, elems)
+		return
+	}
+
+	if sameElement(v, new) {
+		patchElement(v, new)
+		return
+	}
+
+	buildElement(new, v.parentNode, v.domNode())
+	removeElement(v)
+}
+
+// Release removes the event listeners of the built element tree and
+// forgets it, the nodes stay in the dom but the element can't be
+// updated anymore, it should be called when the app stops
+func Release(e Element) {
+	defer runLifecycle()
+
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	if v, ok := vnodes[e]; ok {
+		unregister(v)
+	}
+}
+
+// Unmount removes the nodes of the built element tree from the
+// dom, releases their listeners and disposes the elements
+func Unmount(e Element) {
+	defer runLifecycle()
+
+	lockPatch()
+	defer vnodesMutex.Unlock()
+
+	if v, ok := vnodes[e]; ok {
+		removeElement(v)
+	}
+}
+
+// sameElement reports if the vnode can be patched
+// with the given element instead of being rebuilt
+func sameElement(v *vnode, elem Element) bool {
+	if dynamic, ok := elem.(*DynamicEl); ok {
+		old, ok := v.el.(*DynamicEl)
+		return ok && old.Component == dynamic.Component
+	}
+
+	return reflect.TypeOf(v.el) == reflect.TypeOf(elem) &&
+		v.name == elem.GetElName()
+}
+
+func patchElement(v *vnode, elem Element) {
+	if v.el != elem {
+		// a component element keeps its instance
+		old, ok := v.el.(*DynamicEl)
+		if dynamic, isDynamic := elem.(*DynamicEl); ok && isDynamic && old.Component != nil {
+			dynamic.Instance = old.Instance
+		} else {
+			disposeElement(v.el)
+		}
+
+		delete(vnodes, v.el)
+		v.el = elem
+		vnodes[elem] = v
+	}
+
+	v.key = elementKey(elem)
+
+	switch el := elem.(type) {
+	case *TextEl:
+		// only the text node content is changed
+		if el.InnerText != v.text {
+			v.text = el.InnerText
+			dom.SetNodeValue(v.node, el.InnerText)
+		}
+
+		el.ElValue = v.node
+
+	case *EmptyEl:
+
+	case *SliceEl:
+		el.ElValue = v.start
+
+		v.children = patchChildren(v, el.GetChilds())
+
+	case *DynamicEl:
+		v.children = patchChildren(v, []Element{el.render()})
+
+		el.ElValue = v.children[0].domNode()
+
+		if el.Updated != nil {
+			lifecycle = append(lifecycle, el.Updated)
+		}
+
+	default:
+		buildElementAttributes(v, el)
+		buildElementStyles(v, el)
+
+		if textarea, ok := el.(*TextareaEl); ok && textarea.Value != v.text {
+			v.text = textarea.Value
+			dom.SetText(v.node, textarea.Value)
+		}
+
+		setElValue(el, v.node)
+
+		v.children = patchChildren(v, el.GetChilds())
+
+		buildElementBinding(v, el)
+	}
+}
+
+func patchChildren(parent *vnode, elems []Element) []*vnode {
+	old := parent.children
+
+	if isKeyed(elems) {
+		return patchKeyedChildren(parent, elems)
+	}
+
+	children := make([]*vnode, len(elems))
+
+	for i, elem := range elems {
+		if i < len(old) && sameElement(old[i], elem) {
+			patchElement(old[i], elem)
+			children[i] = old[i]
+			continue
+		}
+
+		// new element is inserted at the old one place
+		var ref Node
+		if i < len(old) {
+			ref = nextNode(old[i:])
+		}
+		if ref == nil {
+			ref = parent.endRef()
+		}
+
+		children[i] = buildElement(elem, parent.container(), ref)
+		children[i].parent = parent
+
+		if i < len(old) {
+			removeElement(old[i])
+		}
+	}
+
+	for i := len(elems); i < len(old); i++ {
+		removeElement(old[i])
+	}
+
+	return children
+}
+
+// patchKeyedChildren matches the old and new childs by key, so nodes
+// are moved, inserted and removed instead of being patched in place
+func patchKeyedChildren(parent *vnode, elems []Element) []*vnode {
+	old := parent.children
+
+	oldIndexes := make(map[*vnode]int, len(old))
+	oldByKey := make(map[interface{}]*vnode, len(old))
+	for i, child := range old {
+		oldIndexes[child] = i
+		if child.key != nil {
+			oldByKey[child.key] = child
+		}
+	}
+
+	kept := make(map[*vnode]bool, len(old))
+
+	children := make([]*vnode, len(elems))
+	for i, elem := range elems {
+		key := elementKey(elem)
+
+		if child, ok := oldByKey[key]; ok && sameElement(child, elem) {
+			delete(oldByKey, key)
+			kept[child] = true
+
+			patchElement(child, elem)
+			children[i] = child
+		}
+	}
+
+	for _, child := range old {
+		if !kept[child] {
+			removeElement(child)
+		}
+	}
+
+	// place childs from the end, a kept child doesn't move while the
+	// old order is respected, others are moved before the next node
+	ref := parent.endRef()
+	nextOldIndex := len(old)
+
+	for i := len(elems) - 1; i >= 0; i-- {
+		child := children[i]
+
+		if child == nil {
+			child = buildElement(elems[i], parent.container(), ref)
+			child.parent = parent
+			children[i] = child
+
+		} else if oldIndex := oldIndexes[child]; oldIndex < nextOldIndex {
+			nextOldIndex = oldIndex
+
+		} else if child.domNode() != nil {
+			moveElement(child, ref)
+		}
+
+		if node := child.domNode(); node != nil {
+			ref = node
+		}
+	}
+
+	return children
+}
+
+// isKeyed reports if every elements have a key
+func isKeyed(elems []Element) bool {
+	for _, elem := range elems {
+		if elementKey(elem) == nil {
+			return false
+		}
+	}
+
+	return len(elems) > 0
+}
+
+// elementKey returns the element key or nil if it
+// has no key or if it can't be used as a map key
+func elementKey(elem Element) interface{} {
+	key := elem.GetKey()
+	if key == nil {
+		return nil
+	}
+
+	if !reflect.TypeOf(key).Comparable() {
+		log.Printf("%s element key %v is not comparable", elem.GetElName(), key)
+		return nil
+	}
+
+	return key
+}
+
+// nextNode returns the first node that is in the dom in the
+// given vnodes, it's used as reference to insert new nodes
+func nextNode(list []*vnode) Node {
+	for _, v := range list {
+		if node := v.domNode(); node != nil {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// domNode returns the node of the vnode that is in the dom
+func (v *vnode) domNode() Node {
+	if v.transparent {
+		return nextNode(v.children)
+	}
+
+	if v.fragment {
+		return v.start
+	}
+
+	return v.node
+}
+
+// container returns the node in which the vnode childs are
+func (v *vnode) container() Node {
+	if v.transparent || v.fragment {
+		return v.parentNode
+	}
+
+	return v.node
+}
+
+// endRef returns the node before which a child appended
+// to the vnode must be inserted, nil means at the end
+func (v *vnode) endRef() Node {
+	if v.fragment {
+		return v.end
+	}
+
+	if !v.transparent || v.parent == nil {
+		return nil
+	}
+
+	for i, sibling := range v.parent.children {
+		if sibling == v {
+			if node := nextNode(v.parent.children[i+1:]); node != nil {
+				return node
+			}
+			break
+		}
+	}
+
+	return v.parent.endRef()
+}
+
+// moveElement moves the vnode nodes before ref
+func moveElement(v *vnode, ref Node) {
+	if v.transparent {
+		for _, child := range v.children {
+			moveElement(child, ref)
+		}
+		return
+	}
+
+	if v.fragment {
+		dom.InsertBefore(v.parentNode, v.start, ref)
+		for _, child := range v.children {
+			moveElement(child, ref)
+		}
+		dom.InsertBefore(v.parentNode, v.end, ref)
+		return
+	}
+
+	if node := v.domNode(); node != nil {
+		dom.InsertBefore(v.parentNode, node, ref)
+	}
+}
+
+// removeElement removes the vnode node from the dom and forget it
+func removeElement(v *vnode) {
+	switch {
+	case v.transparent:
+		for _, child := range v.children {
+			removeElement(child)
+		}
+
+	case v.fragment:
+		for _, child := range v.children {
+			removeElement(child)
+		}
+
+		dom.RemoveChild(v.parentNode, v.start)
+		dom.RemoveChild(v.parentNode, v.end)
+
+	case v.node != nil:
+		dom.RemoveChild(v.parentNode, v.node)
+	}
+
+	unregister(v)
+}
+
+func unregister(v *vnode) {
+	if vnodes[v.el] == v {
+		delete(vnodes, v.el)
+		disposeElement(v.el)
+	}
+
+	// release the listeners of the removed nodes
+	for event, remove := range v.listeners {
+		remove()
+		delete(v.listeners, event)
+	}
+
+	if v.removeBinding != nil {
+		v.removeBinding()
+		v.removeBinding = nil
+	}
+
+	stopBindingWatch(v)
+	stopStyleWatches(v)
+
+	for _, child := range v.children {
+		unregister(child)
+	}
+}
+
+// disposeElement is called when an element is not in the dom anymore
+func disposeElement(elem Element) {
+	if dynamic, ok := elem.(*DynamicEl); ok && dynamic.Dispose != nil {
+		lifecycle = append(lifecycle, dynamic.Dispose)
+	}
+}
@@ -0,0 +1,249 @@
+package elements
+
+import "testing"
+
+func TestPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		before Element
+		after  Element
+		want   string
+		// kept reports if the root node must be patched in place
+		kept bool
+	}{
+		{
+			name:   "text is changed in place",
+			before: P()(Text("a")),
+			after:  P()(Text("b")),
+			want:   `<p>b</p>`,
+			kept:   true,
+		},
+		{
+			name:   "attributes are changed and removed",
+			before: Div(ID("a"), Class("x"))(),
+			after:  Div(ID("b"))(),
+			want:   `<div id="b"></div>`,
+			kept:   true,
+		},
+		{
+			name:   "childs are appended",
+			before: Ul()(Li()(Text("a"))),
+			after:  Ul()(Li()(Text("a")), Li()(Text("b"))),
+			want:   `<ul><li>a</li><li>b</li></ul>`,
+			kept:   true,
+		},
+		{
+			name:   "childs are removed",
+			before: Ul()(Li()(Text("a")), Li()(Text("b"))),
+			after:  Ul()(Li()(Text("a"))),
+			want:   `<ul><li>a</li></ul>`,
+			kept:   true,
+		},
+		{
+			name:   "a child of another type is replaced",
+			before: Div()(Span()(Text("a")), P()()),
+			after:  Div()(Strong()(Text("a")), P()()),
+			want:   `<div><strong>a</strong><p></p></div>`,
+			kept:   true,
+		},
+		{
+			name:   "fragment childs are patched between the anchors",
+			before: Div()(Fragment(Span()(Text("a"))), P()()),
+			after:  Div()(Fragment(Span()(Text("b")), Span()(Text("c"))), P()()),
+			want:   `<div><!--[--><span>b</span><span>c</span><!--]--><p></p></div>`,
+			kept:   true,
+		},
+		{
+			name:   "a fragment is emptied",
+			before: Div()(Text("a"), Fragment(Span()(), Span()()), Text("b")),
+			after:  Div()(Text("a"), Fragment(), Text("b")),
+			want:   `<div>a<!--[--><!--]-->b</div>`,
+			kept:   true,
+		},
+		{
+			name:   "an element of another type is rebuilt",
+			before: Div()(Text("a")),
+			after:  Section()(Text("a")),
+			want:   `<section>a</section>`,
+			kept:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := mount(t, test.before)
+			root := body.Children[0]
+
+			Patch(test.before, test.after)
+
+			if got := memoryHTML(body); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+
+			if kept := body.Children[0] == root; kept != test.kept {
+				t.Errorf("root node kept = %v, want %v", kept, test.kept)
+			}
+		})
+	}
+}
+
+func TestPatchKeepsUntouchedNodes(t *testing.T) {
+	before := Div()(Input(Type("text"))(), P()(Text("a")))
+	body := mount(t, before)
+
+	// the user typed in the input
+	input := body.Children[0].Children[0]
+	input.Properties = map[string]interface{}{"value": "typed"}
+
+	Patch(before, Div()(Input(Type("text"))(), P()(Text("b"))))
+
+	if body.Children[0].Children[0] != input {
+		t.Fatal("the input was rebuilt")
+	}
+	if input.Properties["value"] != "typed" {
+		t.Errorf("the input value is %v, want typed", input.Properties["value"])
+	}
+}
+
+func TestPatchTextareaValue(t *testing.T) {
+	before := Textarea(Value("a"))()
+	body := mount(t, before)
+
+	// the user typed in the textarea
+	textarea := body.Children[0]
+	textarea.Properties["value"] = "typed"
+
+	Patch(before, Textarea(Value("b"))())
+
+	if got := GetDOM().GetProperty(textarea, "value"); got != "b" {
+		t.Errorf("the textarea value is %v, want b", got)
+	}
+}
+
+func TestUpdateDynamic(t *testing.T) {
+	count := 0
+	dynamic := Dynamic(func() Element {
+		return P()(Textf("%d", count))
+	})
+
+	body := mount(t, Div()(dynamic))
+	p := body.Children[0].Children[0]
+
+	for count = 1; count <= 3; count++ {
+		Update(dynamic)
+	}
+
+	if got, want := memoryHTML(body), `<div><p>3</p></div>`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if body.Children[0].Children[0] != p {
+		t.Error("the paragraph was rebuilt")
+	}
+}
+
+// keyedList returns a list of items keyed by their text
+func keyedList(items ...string) Element {
+	childs := make([]Element, len(items))
+	for i, item := range items {
+		childs[i] = Li(Key(item))(Text(item))
+	}
+
+	return Ul()(childs...)
+}
+
+func TestPatchKeyed(t *testing.T) {
+	tests := []struct {
+		name   string
+		before []string
+		after  []string
+	}{
+		{name: "reverse", before: []string{"a", "b", "c"}, after: []string{"c", "b", "a"}},
+		{name: "move first to end", before: []string{"a", "b", "c"}, after: []string{"b", "c", "a"}},
+		{name: "move last to start", before: []string{"a", "b", "c"}, after: []string{"c", "a", "b"}},
+		{name: "insert in the middle", before: []string{"a", "c"}, after: []string{"a", "b", "c"}},
+		{name: "remove in the middle", before: []string{"a", "b", "c"}, after: []string{"a", "c"}},
+		{name: "replace every items", before: []string{"a", "b"}, after: []string{"c", "d"}},
+		{name: "swap and insert", before: []string{"a", "b", "c", "d"}, after: []string{"d", "e", "b", "a"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := keyedList(test.before...)
+			body := mount(t, before)
+
+			nodes := make(map[string]*MemoryNode)
+			for _, li := range body.Children[0].Children {
+				nodes[li.Children[0].Text] = li
+			}
+
+			Patch(before, keyedList(test.after...))
+
+			want := memoryHTML(mount(t, keyedList(test.after...)))
+			if got := memoryHTML(body); got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+
+			// the kept items must be moved, not rebuilt
+			for i, li := range body.Children[0].Children {
+				if old, ok := nodes[test.after[i]]; ok && old != li {
+					t.Errorf("item %s was rebuilt", test.after[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicElValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		render func() Element
+		// node returns the node the ElValue must be
+		node func(body *MemoryNode) *MemoryNode
+	}{
+		{
+			name:   "element",
+			render: func() Element { return Span()() },
+			node:   func(body *MemoryNode) *MemoryNode { return body.Children[0] },
+		},
+		{
+			name:   "fragment",
+			render: func() Element { return Fragment(Span()()) },
+			// the start anchor
+			node: func(body *MemoryNode) *MemoryNode { return body.Children[0] },
+		},
+		{
+			name: "dynamic",
+			render: func() Element {
+				return Dynamic(func() Element { return P()() })
+			},
+			node: func(body *MemoryNode) *MemoryNode { return body.Children[0] },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builds := map[string]func(dynamic *DynamicEl) *MemoryNode{
+				"mount": func(dynamic *DynamicEl) *MemoryNode { return mount(t, dynamic) },
+				"update": func(dynamic *DynamicEl) *MemoryNode {
+					body := mount(t, dynamic)
+					Update(dynamic)
+					return body
+				},
+				"hydrate": func(dynamic *DynamicEl) *MemoryNode {
+					body := prerender(t, Dynamic(test.render))
+					Hydrate(dynamic, body)
+					return body
+				},
+			}
+
+			for name, build := range builds {
+				dynamic := Dynamic(test.render)
+				body := build(dynamic)
+
+				if node := test.node(body); dynamic.ElValue != node {
+					t.Errorf("%s: the ElValue is %v, want the <%s> node", name, dynamic.ElValue, node.Tag)
+				}
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
+//go:build js && wasm
+
 package main
 
 import (
@@ -5,15 +7,21 @@ import (
 	"time"
 
 	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/css"
 	. "github.com/4lxprime/gtml/elements"
 	"github.com/4lxprime/gtml/runtime"
 )
 
 func Index(app *gtml.App) *gtml.App {
-	paddingState := app.UseState(20)
+	paddingState := gtml.UseState(app, 20)
+
+	// the padding follows the state without rendering the elements again
+	padding := css.Bind(paddingState, func(padding int) css.Declaration {
+		return css.Padding(css.Px(padding))
+	})
 
 	clickP := P(
-		Style("color: blue;"),
+		css.Style(css.Color(css.RGB(0, 0, 255))),
 	)(
 		Text("Click on the button:"),
 	)
@@ -25,11 +33,11 @@ func Index(app *gtml.App) *gtml.App {
 		Update(clickP)
 	}()
 
-	return app.Use(
-		Div(
-			Style(
-				"background-color: cyan;",
-				fmt.Sprintf("padding: %vpx;", paddingState.Get()),
+	return app.Use(app.Reactive(func() Element {
+		return Div(
+			css.Style(
+				css.BackgroundColor(css.Hex("#0ff")),
+				padding,
 			),
 		)(
 			gtml.If(1 == 3)(
@@ -62,24 +70,21 @@ func Index(app *gtml.App) *gtml.App {
 				Href("https://google.com"),
 			)(),
 			Button(
-				Style(
-					fmt.Sprintf(
-						"padding: %vpx;",
-						paddingState.Get(),
-					),
-					"margin: 5px;",
-					"color: red;",
+				css.Style(
+					padding,
+					css.Margin(css.Px(5)),
+					css.Color(css.RGB(255, 0, 0)),
 				),
 				Type("submit"),
 				OnClick(func() {
 					fmt.Println("button get clicked")
-					paddingState.Set(paddingState.Get().(int) + 1)
+					paddingState.Update(func(padding int) int { return padding + 1 })
 				}),
 			)(
 				Text("Hello World!"),
 			),
-		),
-	)
+		)
+	}))
 }
 
 func main() {
@@ -6,7 +6,7 @@ func main() {
 	http.ListenAndServe(
 		":8000",
 		http.FileServer(
-			http.Dir("app/static"),
+			http.Dir("dist"),
 		),
 	)
 }
@@ -0,0 +1,333 @@
+package forms
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// Validator checks a field value, the returned error message
+// is the field error, it returns nil if the value is valid
+type Validator func(value string) error
+
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)
+
+// Field is a field of a form, see Form.Field
+type Field struct {
+	Name        string
+	form        *Form
+	constraints []elements.Attribute
+	validators  []Validator
+	// async validators run in a goroutine each time the value
+	// changes, their error is kept in asyncError
+	asyncValidators []Validator
+	initial         string
+	value           *gtml.State[string]
+	touched         *gtml.State[bool]
+	asyncError      *gtml.State[string]
+	validating      *gtml.State[bool]
+	// checkbox is set by Checkbox, the value
+	// of an unchecked box is "false"
+	checkbox bool
+	// async is the async validation run, only the
+	// last one sets the field error
+	async *asyncRun
+}
+
+type asyncRun struct {
+	mutex sync.Mutex
+	id    int64
+}
+
+func newField(form *Form, name string, constraints []elements.Attribute) *Field {
+	// the value attribute is the initial value
+	initial := ""
+	for _, constraint := range constraints {
+		if constraint.Name == "Value" {
+			initial = fmt.Sprint(constraint.Value)
+		}
+	}
+
+	return &Field{
+		Name:        name,
+		form:        form,
+		constraints: constraints,
+		initial:     initial,
+		value:       gtml.UseState(form.scope, initial),
+		touched:     gtml.UseState(form.scope, false),
+		asyncError:  gtml.UseState(form.scope, ""),
+		validating:  gtml.UseState(form.scope, false),
+		async:       gtml.UseState(form.scope, &asyncRun{}).Get(),
+	}
+}
+
+// Validate adds a validator to the field
+func (f *Field) Validate(validator Validator) *Field {
+	f.validators = append(f.validators, validator)
+
+	return f
+}
+
+// ValidateAsync adds a validator running in a goroutine (e.g. a
+// request checking that a name is available), it runs each time
+// the value changes and when the form is submitted
+func (f *Field) ValidateAsync(validator Validator) *Field {
+	f.asyncValidators = append(f.asyncValidators, validator)
+
+	return f
+}
+
+// Value returns the field value
+func (f *Field) Value() string { return f.value.Get() }
+
+// SetValue changes the field value and runs the async validators
+func (f *Field) SetValue(value string) {
+	f.value.Set(value)
+
+	if len(f.asyncValidators) > 0 {
+		go f.validateAsync()
+	}
+}
+
+// Dirty reports if the value changed since the form was created or reset
+func (f *Field) Dirty() bool { return f.value.Get() != f.initial }
+
+// Touched reports if the field lost the focus once (or the
+// form was submitted), its error is only visible after
+func (f *Field) Touched() bool { return f.touched.Get() }
+
+// Validating reports if async validators are running
+func (f *Field) Validating() bool { return f.validating.Get() }
+
+// Error returns the first error of the field, or "" if it's valid
+func (f *Field) Error() string { return f.error(f.Value(), f.asyncError.Get()) }
+
+// peekError returns the error without tracking the states,
+// it's read outside of the renders (e.g. in Form.Submit)
+func (f *Field) peekError() string { return f.error(f.value.Peek(), f.asyncError.Peek()) }
+
+func (f *Field) error(value, asyncError string) string {
+	if err := f.checkConstraints(value); err != nil {
+		return err.Error()
+	}
+
+	for _, validator := range f.validators {
+		if err := validator(value); err != nil {
+			return err.Error()
+		}
+	}
+
+	return asyncError
+}
+
+// VisibleError returns the error once the field is touched or
+// the form submitted, so an empty form doesn't show every errors
+func (f *Field) VisibleError() string {
+	if !f.Touched() && !f.form.Submitted() {
+		return ""
+	}
+
+	return f.Error()
+}
+
+// Valid reports if the field has no error
+func (f *Field) Valid() bool { return f.Error() == "" }
+
+// Reset sets the field back to its initial value
+func (f *Field) Reset() {
+	f.value.Set(f.initial)
+	f.touched.Set(false)
+	f.asyncError.Set("")
+}
+
+func (f *Field) checkConstraints(value string) error {
+	for _, constraint := range f.constraints {
+		switch constraint.Name {
+		case "Required":
+			if f.isCheckbox() && value != "true" {
+				return errors.New("this field must be checked")
+			}
+			if value == "" {
+				return errors.New("this field is required")
+			}
+
+		case "Pattern":
+			pattern, ok := constraint.Value.(string)
+			// like in the browser, the pattern must match the whole value
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if ok && err == nil && value != "" && !re.MatchString(value) {
+				return errors.New("this field doesn't match the expected format")
+			}
+
+		case "Type":
+			switch constraint.Value {
+			case "email":
+				if value != "" && !emailPattern.MatchString(value) {
+					return errors.New("this field must be an email address")
+				}
+
+			case "number", "range":
+				if _, err := strconv.ParseFloat(value, 64); value != "" && err != nil {
+					return errors.New("this field must be a number")
+				}
+			}
+
+		case "Min", "Max":
+			limit, _ := constraint.Value.(int64)
+
+			number, err := strconv.ParseFloat(value, 64)
+			if value == "" || err != nil {
+				continue
+			}
+
+			if constraint.Name == "Min" && number < float64(limit) {
+				return fmt.Errorf("this field must be at least %d", limit)
+			}
+			if constraint.Name == "Max" && number > float64(limit) {
+				return fmt.Errorf("this field must be at most %d", limit)
+			}
+
+		case "MinLength", "MaxLength":
+			limit, _ := constraint.Value.(int64)
+			length := int64(utf8.RuneCountInString(value))
+
+			if constraint.Name == "MinLength" && value != "" && length < limit {
+				return fmt.Errorf("this field must have at least %d characters", limit)
+			}
+			if constraint.Name == "MaxLength" && length > limit {
+				return fmt.Errorf("this field must have at most %d characters", limit)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isCheckbox reports if the field is rendered by Checkbox
+// or if its type constraint is checkbox
+func (f *Field) isCheckbox() bool {
+	for _, constraint := range f.constraints {
+		if constraint.Name == "Type" && constraint.Value == "checkbox" {
+			return true
+		}
+	}
+
+	return f.checkbox
+}
+
+// validateAsync runs the async validators, the result is
+// dropped if the value changed while they were running
+func (f *Field) validateAsync() {
+	if len(f.asyncValidators) == 0 {
+		return
+	}
+
+	f.async.mutex.Lock()
+	f.async.id++
+	id := f.async.id
+	f.async.mutex.Unlock()
+
+	f.validating.Set(true)
+
+	// it runs in a goroutine, the value isn't tracked
+	value := f.value.Peek()
+
+	message := ""
+	for _, validator := range f.asyncValidators {
+		if err := validator(value); err != nil {
+			message = err.Error()
+			break
+		}
+	}
+
+	f.async.mutex.Lock()
+	defer f.async.mutex.Unlock()
+
+	if id != f.async.id {
+		return
+	}
+
+	f.asyncError.Set(message)
+	f.validating.Set(false)
+}
+
+// attributes returns the attributes of the field element: its
+// name, the constraints, the value binding and the touched tracking
+func (f *Field) attributes(attributes []elements.Attribute) []elements.Attribute {
+	attrs := []elements.Attribute{elements.Name(f.Name)}
+
+	for _, constraint := range f.constraints {
+		// the value is bound below
+		if constraint.Name != "Value" {
+			attrs = append(attrs, constraint)
+		}
+	}
+
+	return append(
+		append(attrs, attributes...),
+		elements.Attribute{
+			Name: "Bind",
+			Value: &elements.Binding{
+				Property: "value",
+				Event:    "input",
+				Value:    f.Value(),
+				Set: func(value interface{}) {
+					f.SetValue(fmt.Sprint(value))
+				},
+			},
+		},
+		elements.OnBlur(func() { f.touched.Set(true) }),
+	)
+}
+
+// Input returns the input element of the field
+//
+// NOTE: the Name, OnBlur and value binding attributes are set by the field
+func (f *Field) Input(attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	return elements.Input(f.attributes(attributes)...)
+}
+
+// Checkbox returns the checkbox input of the field, its
+// value is "true" when it's checked and "false" otherwise
+func (f *Field) Checkbox(attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	f.checkbox = true
+
+	attrs := append(
+		[]elements.Attribute{elements.Name(f.Name), elements.Type("checkbox")},
+		attributes...,
+	)
+
+	return elements.Input(append(
+		attrs,
+		elements.Attribute{
+			Name: "Bind",
+			Value: &elements.Binding{
+				Property: "checked",
+				Event:    "change",
+				Value:    f.Value() == "true",
+				Set: func(value interface{}) {
+					checked, _ := value.(bool)
+					f.SetValue(strconv.FormatBool(checked))
+				},
+			},
+		},
+		elements.OnBlur(func() { f.touched.Set(true) }),
+	)...)
+}
+
+// Textarea returns the textarea element of the field
+func (f *Field) Textarea(attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	return elements.Textarea(f.attributes(attributes)...)
+}
+
+// Select returns the select element of the field, the
+// options are given as its childs
+func (f *Field) Select(attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	return elements.Select(f.attributes(attributes)...)
+}
@@ -0,0 +1,163 @@
+package forms
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// mount builds the element in a new memory backend
+// and starts the app state manager
+func mount(app *gtml.App, el elements.Element) *elements.MemoryNode {
+	d := elements.NewMemoryDOM()
+	elements.SetDOM(d)
+
+	elements.Mount(el, d.Body())
+	app.StateManager.Start()
+
+	return d.Body().(*elements.MemoryNode)
+}
+
+func TestConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints []elements.Attribute
+		checkbox    bool
+		value       string
+		// want is a part of the error, "" if the value is valid
+		want string
+	}{
+		{name: "required", constraints: []elements.Attribute{elements.Required}, value: "", want: "required"},
+		{name: "required set", constraints: []elements.Attribute{elements.Required}, value: "a"},
+		{name: "required checkbox unchecked", constraints: []elements.Attribute{elements.Required}, checkbox: true, value: "false", want: "checked"},
+		{name: "required checkbox checked", constraints: []elements.Attribute{elements.Required}, checkbox: true, value: "true"},
+		{name: "required checkbox type", constraints: []elements.Attribute{elements.Required, elements.Type("checkbox")}, value: "false", want: "checked"},
+		{name: "pattern", constraints: []elements.Attribute{elements.Pattern("[a-z]+")}, value: "abc"},
+		{name: "pattern whole value", constraints: []elements.Attribute{elements.Pattern("[a-z]+")}, value: "abc1", want: "format"},
+		{name: "pattern empty", constraints: []elements.Attribute{elements.Pattern("[a-z]+")}, value: ""},
+		{name: "email", constraints: []elements.Attribute{elements.Type("email")}, value: "a@b.c"},
+		{name: "invalid email", constraints: []elements.Attribute{elements.Type("email")}, value: "a.b", want: "email"},
+		{name: "number", constraints: []elements.Attribute{elements.Type("number")}, value: "-1.5"},
+		{name: "invalid number", constraints: []elements.Attribute{elements.Type("number")}, value: "1a", want: "number"},
+		{name: "min", constraints: []elements.Attribute{elements.Min(18)}, value: "17", want: "at least 18"},
+		{name: "min reached", constraints: []elements.Attribute{elements.Min(18)}, value: "18"},
+		{name: "max", constraints: []elements.Attribute{elements.Max(10)}, value: "10.5", want: "at most 10"},
+		{name: "min length", constraints: []elements.Attribute{elements.MinLength(3)}, value: "ab", want: "at least 3 characters"},
+		{name: "min length runes", constraints: []elements.Attribute{elements.MinLength(3)}, value: "été"},
+		{name: "min length empty", constraints: []elements.Attribute{elements.MinLength(3)}, value: ""},
+		{name: "max length", constraints: []elements.Attribute{elements.MaxLength(2)}, value: "abc", want: "at most 2 characters"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			field := New(gtml.NewApp(), nil).Field("field", test.constraints...)
+			if test.checkbox {
+				field.Checkbox()
+			}
+			field.SetValue(test.value)
+
+			got := field.Error()
+			if test.want == "" && got != "" {
+				t.Errorf("got error %q, want none", got)
+			}
+			if test.want != "" && !strings.Contains(got, test.want) {
+				t.Errorf("got error %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDirtyTouchedReset(t *testing.T) {
+	app := gtml.NewApp()
+	form := New(app, nil)
+	name := form.Field("name", elements.Required, elements.Value("bob"))
+
+	body := mount(app, name.Input()())
+	input := body.Children[0]
+
+	if name.Dirty() || name.Touched() || name.VisibleError() != "" {
+		t.Fatal("a new field is dirty, touched or shows its error")
+	}
+
+	// the user clears the input and leaves it
+	elements.GetDOM().SetProperty(input, "value", "")
+	input.Dispatch("input")
+	input.Dispatch("blur")
+
+	if !name.Dirty() || !form.Dirty() {
+		t.Error("the changed field isn't dirty")
+	}
+	if !name.Touched() {
+		t.Error("the field isn't touched after the blur")
+	}
+	if !strings.Contains(name.VisibleError(), "required") {
+		t.Errorf("got visible error %q, want the required error", name.VisibleError())
+	}
+
+	form.Reset()
+
+	if name.Value() != "bob" || name.Dirty() || name.Touched() || name.VisibleError() != "" {
+		t.Errorf("the reset field is %q, dirty %v, touched %v", name.Value(), name.Dirty(), name.Touched())
+	}
+}
+
+func TestSubmitAsync(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "valid", value: "bob"},
+		{name: "invalid", value: "taken", want: "this name is taken"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := gtml.NewApp()
+
+			var submitted Values
+			form := New(app, func(values Values) { submitted = values })
+
+			release := make(chan struct{})
+			name := form.Field("name", elements.Value(test.value)).ValidateAsync(func(value string) error {
+				<-release
+				if value == "taken" {
+					return errors.New("this name is taken")
+				}
+				return nil
+			})
+
+			body := mount(app, form.Form()(name.Input()()))
+			body.Children[0].Dispatch("submit")
+
+			if !form.Submitting() || !name.Touched() {
+				t.Error("the form isn't submitting or its fields aren't touched")
+			}
+
+			close(release)
+
+			// the submit is done when submitting is set back
+			deadline := time.Now().Add(time.Second)
+			for form.submitting.Peek() {
+				if time.Now().After(deadline) {
+					t.Fatal("the submit never ends")
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if got := name.Error(); got != test.want {
+				t.Errorf("got error %q, want %q", got, test.want)
+			}
+			if test.want == "" && submitted["name"] != test.value {
+				t.Errorf("got submitted values %v, want the name %s", submitted, test.value)
+			}
+			if test.want != "" && submitted != nil {
+				t.Errorf("the invalid form is submitted with %v", submitted)
+			}
+		})
+	}
+}
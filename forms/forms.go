@@ -0,0 +1,197 @@
+// Package forms keeps the state of forms: the fields values, their
+// validation (the html constraints are mirrored in go, plus custom
+// and async validators), the dirty and touched fields and the submit
+package forms
+
+import (
+	"sync"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// Values are the fields values by name
+type Values map[string]string
+
+// Form is a set of fields submitted together, every state of the
+// form (values, errors, validity) is reactive, reading it in a
+// reactive element or a component renders it again when it changes
+//
+// NOTE: in a component the form and its fields are hooks, they
+// must be created in the same order at each render
+//
+// example:
+//
+//	form := forms.New(ctx, func(values forms.Values) {
+//		fmt.Println("signed up", values["email"])
+//	})
+//
+//	email := form.Field("email", Required, Type("email"))
+//	password := form.Field("password", Required, MinLength(8))
+//
+//	return form.Form()(
+//		email.Input()(),
+//		Textf("%s", email.VisibleError()),
+//		password.Input(Type("password"))(),
+//		Textf("%s", password.VisibleError()),
+//		Button(Type("submit"))(Text("Sign up")),
+//	)
+type Form struct {
+	scope      gtml.Scope
+	fields     []*Field
+	onSubmit   func(Values)
+	submitted  *gtml.State[bool]
+	submitting *gtml.State[bool]
+}
+
+// New returns a form calling onSubmit with the values when
+// it's submitted and every field is valid
+func New(scope gtml.Scope, onSubmit func(values Values)) *Form {
+	return &Form{
+		scope:      scope,
+		onSubmit:   onSubmit,
+		submitted:  gtml.UseState(scope, false),
+		submitting: gtml.UseState(scope, false),
+	}
+}
+
+// Field adds a field to the form, the constraints attributes
+// (Required, Pattern, Min, Max, MinLength, MaxLength and the email
+// and number Type) are checked in go and given to the field element
+func (f *Form) Field(name string, constraints ...elements.Attribute) *Field {
+	field := newField(f, name, constraints)
+	f.fields = append(f.fields, field)
+
+	return field
+}
+
+// Fields returns the fields of the form
+func (f *Form) Fields() []*Field { return f.fields }
+
+// Values returns the fields values
+func (f *Form) Values() Values {
+	values := make(Values, len(f.fields))
+	for _, field := range f.fields {
+		values[field.Name] = field.Value()
+	}
+
+	return values
+}
+
+// Valid reports if every field is valid and
+// none is waiting for an async validator
+func (f *Form) Valid() bool {
+	valid := true
+	for _, field := range f.fields {
+		// every field is read so they are all tracked
+		valid = field.Valid() && !field.Validating() && valid
+	}
+
+	return valid
+}
+
+// peekValid is Valid without tracking the states, the submit
+// isn't a render and its goroutine can't track them
+func (f *Form) peekValid() bool {
+	for _, field := range f.fields {
+		if field.peekError() != "" || field.validating.Peek() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// peekValues is Values without tracking the states
+func (f *Form) peekValues() Values {
+	values := make(Values, len(f.fields))
+	for _, field := range f.fields {
+		values[field.Name] = field.value.Peek()
+	}
+
+	return values
+}
+
+// Dirty reports if a field value changed
+func (f *Form) Dirty() bool {
+	dirty := false
+	for _, field := range f.fields {
+		dirty = field.Dirty() || dirty
+	}
+
+	return dirty
+}
+
+// Submitted reports if the form was submitted,
+// every field errors are visible after
+func (f *Form) Submitted() bool { return f.submitted.Get() }
+
+// Submitting reports if the async validators of a submit are running
+func (f *Form) Submitting() bool { return f.submitting.Get() }
+
+// Reset sets every field back to its initial value
+// and forgets that the fields were touched
+func (f *Form) Reset() {
+	for _, field := range f.fields {
+		field.Reset()
+	}
+
+	f.submitted.Set(false)
+}
+
+// Submit validates the form and calls the submit function if it's
+// valid, the async validators are awaited in a goroutine before
+func (f *Form) Submit() {
+	f.submitted.Set(true)
+
+	async := false
+	for _, field := range f.fields {
+		field.touched.Set(true)
+		async = async || len(field.asyncValidators) > 0
+	}
+
+	if !async {
+		if f.peekValid() && f.onSubmit != nil {
+			f.onSubmit(f.peekValues())
+		}
+		return
+	}
+
+	f.submitting.Set(true)
+
+	go func() {
+		defer f.submitting.Set(false)
+
+		var wg sync.WaitGroup
+		for _, field := range f.fields {
+			wg.Add(1)
+			go func(field *Field) {
+				defer wg.Done()
+				field.validateAsync()
+			}(field)
+		}
+		wg.Wait()
+
+		if f.peekValid() && f.onSubmit != nil {
+			f.onSubmit(f.peekValues())
+		}
+	}()
+}
+
+// Form returns the form element, submitting it calls Submit
+// instead of the browser navigation, the browser validation
+// is disabled as the fields errors are given by the form
+//
+// NOTE: the OnSubmit attribute is set by the form
+func (f *Form) Form(attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	attributes = append(
+		attributes,
+		elements.NoValidate,
+		elements.OnSubmit(func(e elements.SubmitEvent) {
+			e.PreventDefault()
+			f.Submit()
+		}),
+	)
+
+	return elements.Form(attributes...)
+}
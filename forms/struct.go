@@ -0,0 +1,269 @@
+package forms
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// StructForm is a form generated from the fields of a struct, see For,
+// every method of Form can be used
+type StructForm[T any] struct {
+	*form
+	value  *T
+	fields []structField
+}
+
+// form is embedded so the Form method isn't hidden by the field
+type form = Form
+
+// structField is a form field generated from a struct field
+type structField struct {
+	field *Field
+	label string
+	index []int
+	kind  reflect.Kind
+}
+
+// For returns a form with a field for each exported field of the struct
+// value, the fields are configured by their tags:
+//
+//   - form: the field name, "-" skips the field (the go name by default)
+//   - label: the label of the input (the go name by default)
+//   - validate: the constraints separated by commas, required, email,
+//     min=N, max=N, minlength=N, maxlength=N and pattern=... (the
+//     pattern takes the rest of the tag so it must be the last one)
+//   - type: the input type, by default it's chosen from the go type
+//     (checkbox for a bool, number for numbers and text or email)
+//
+// the inputs are populated from the struct and when the form is
+// submitted and valid the values are decoded back into the struct
+// before onSubmit is called with it
+//
+// NOTE: like New, in a component it's a hook
+//
+// example:
+//
+//	type SignUp struct {
+//		Email string `form:"email" validate:"required,email" label:"E-mail"`
+//		Age   int    `form:"age" validate:"min=18" label:"Age"`
+//		Terms bool   `form:"terms" validate:"required" label:"I accept the terms"`
+//	}
+//
+//	signUp := SignUp{Age: 18}
+//	form := forms.For(ctx, &signUp, func(s *SignUp) {
+//		fmt.Println("signed up", s.Email)
+//	})
+//
+//	return form.Form()(
+//		Fragment(form.Inputs()...),
+//		Button(Type("submit"))(Text("Sign up")),
+//	)
+func For[T any](scope gtml.Scope, value *T, onSubmit func(value *T)) *StructForm[T] {
+	s := &StructForm[T]{value: value}
+
+	s.form = New(scope, func(values Values) {
+		if err := s.decode(values); err != nil {
+			log.Println(err)
+			return
+		}
+
+		if onSubmit != nil {
+			onSubmit(s.value)
+		}
+	})
+
+	v := reflect.ValueOf(value).Elem()
+	if v.Kind() != reflect.Struct {
+		log.Printf("can't create a form for %s, it is not a struct", v.Type())
+		return s
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if field, ok := s.addField(v.Type().Field(i), v.Field(i)); ok {
+			s.fields = append(s.fields, field)
+		}
+	}
+
+	return s
+}
+
+// addField adds the form field of the struct field
+func (s *StructForm[T]) addField(f reflect.StructField, value reflect.Value) (structField, bool) {
+	name := f.Tag.Get("form")
+	if f.PkgPath != "" || name == "-" {
+		return structField{}, false
+	}
+
+	if name == "" {
+		name = f.Name
+	}
+
+	label := f.Tag.Get("label")
+	if label == "" {
+		label = f.Name
+	}
+
+	constraints, err := parseValidate(f.Tag.Get("validate"))
+	if err != nil {
+		log.Printf("invalid validate tag of the %s field: %v", f.Name, err)
+	}
+
+	kind := f.Type.Kind()
+
+	inputType := f.Tag.Get("type")
+	switch {
+	case inputType != "":
+	case kind == reflect.Bool:
+		inputType = "checkbox"
+	case isNumber(kind):
+		inputType = "number"
+	case kind == reflect.String && hasConstraint(constraints, "Type"):
+		// e.g. the email validation
+	case kind == reflect.String:
+		inputType = "text"
+	default:
+		log.Printf("can't create an input for the %s field of type %s", f.Name, f.Type)
+		return structField{}, false
+	}
+
+	if inputType != "" && !hasConstraint(constraints, "Type") {
+		constraints = append(constraints, elements.Type(inputType))
+	}
+
+	// the input is populated from the struct
+	initial := fmt.Sprint(value.Interface())
+	if isNumber(kind) && value.IsZero() {
+		initial = ""
+	}
+	constraints = append(constraints, elements.Value(initial))
+
+	field := s.form.Field(name, constraints...)
+
+	// the value must fit in the go type (e.g. an int8)
+	field.Validate(func(text string) error {
+		if err := elements.SetValueString(reflect.New(f.Type).Elem(), text); err != nil {
+			return fmt.Errorf("this field must be a valid %s", f.Type)
+		}
+		return nil
+	})
+
+	return structField{
+		field: field,
+		label: label,
+		index: f.Index,
+		kind:  kind,
+	}, true
+}
+
+// parseValidate returns the constraints attributes of a validate tag
+func parseValidate(tag string) ([]elements.Attribute, error) {
+	constraints := []elements.Attribute{}
+
+	for tag != "" {
+		rule, rest, _ := strings.Cut(tag, ",")
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch name {
+		case "required":
+			constraints = append(constraints, elements.Required)
+
+		case "email":
+			constraints = append(constraints, elements.Type("email"))
+
+		case "pattern":
+			// the pattern can have commas
+			_, pattern, _ := strings.Cut(tag, "=")
+			return append(constraints, elements.Pattern(pattern)), nil
+
+		case "min", "max", "minlength", "maxlength":
+			limit, err := strconv.ParseInt(param, 10, 64)
+			if err != nil {
+				return constraints, fmt.Errorf("%s must be an integer", name)
+			}
+
+			constraints = append(constraints, map[string]func(int64) elements.Attribute{
+				"min":       elements.Min,
+				"max":       elements.Max,
+				"minlength": elements.MinLength,
+				"maxlength": elements.MaxLength,
+			}[name](limit))
+
+		default:
+			return constraints, fmt.Errorf("unknown rule %q", name)
+		}
+
+		tag = rest
+	}
+
+	return constraints, nil
+}
+
+func hasConstraint(constraints []elements.Attribute, name string) bool {
+	for _, constraint := range constraints {
+		if constraint.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// Value returns the struct the form is bound to
+func (s *StructForm[T]) Value() *T { return s.value }
+
+// Decode decodes the current values of the form into the struct
+func (s *StructForm[T]) Decode() error { return s.decode(s.Values()) }
+
+func (s *StructForm[T]) decode(values Values) error {
+	v := reflect.ValueOf(s.value).Elem()
+
+	for _, f := range s.fields {
+		if err := elements.SetValueString(v.FieldByIndex(f.index), values[f.field.Name]); err != nil {
+			return fmt.Errorf("can't decode the %s field: %w", f.field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Inputs returns a labeled input for each field of the
+// struct followed by the field error once it's visible
+func (s *StructForm[T]) Inputs(attributes ...elements.Attribute) []elements.Element {
+	inputs := make([]elements.Element, len(s.fields))
+
+	for i, f := range s.fields {
+		input := f.field.Input
+		if f.kind == reflect.Bool {
+			input = f.field.Checkbox
+		}
+
+		inputs[i] = elements.Div()(
+			elements.Label()(
+				elements.Text(f.label),
+				input(attributes...)(),
+			),
+			elements.Span()(
+				elements.Text(f.field.VisibleError()),
+			),
+		)
+	}
+
+	return inputs
+}
@@ -0,0 +1,157 @@
+package forms
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+func TestParseValidate(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want []elements.Attribute
+		err  bool
+	}{
+		{tag: "", want: []elements.Attribute{}},
+		{tag: "required,email", want: []elements.Attribute{elements.Required, elements.Type("email")}},
+		{tag: "min=1,max=99", want: []elements.Attribute{elements.Min(1), elements.Max(99)}},
+		{tag: "minlength=2,maxlength=8", want: []elements.Attribute{elements.MinLength(2), elements.MaxLength(8)}},
+		{tag: "pattern=[a-z]{1,3}", want: []elements.Attribute{elements.Pattern("[a-z]{1,3}")}},
+		// the pattern takes the rest of the tag
+		{tag: "required,pattern=a,b=c", want: []elements.Attribute{elements.Required, elements.Pattern("a,b=c")}},
+		{tag: "min=a", want: []elements.Attribute{}, err: true},
+		{tag: "required,unknown", want: []elements.Attribute{elements.Required}, err: true},
+	}
+
+	for _, test := range tests {
+		got, err := parseValidate(test.tag)
+
+		if (err != nil) != test.err {
+			t.Errorf("%q: got error %v, want error %v", test.tag, err, test.err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %v, want %v", test.tag, got, test.want)
+		}
+	}
+}
+
+type signUp struct {
+	Name    string  `form:"name" validate:"required"`
+	Age     int8    `form:"age"`
+	Score   float64 `form:"score"`
+	Terms   bool    `form:"terms" validate:"required"`
+	Ignored string  `form:"-"`
+	private string
+}
+
+// field returns the field of the form with the name
+func field(t *testing.T, form *Form, name string) *Field {
+	t.Helper()
+
+	for _, field := range form.Fields() {
+		if field.Name == name {
+			return field
+		}
+	}
+
+	t.Fatalf("no %s field", name)
+	return nil
+}
+
+func TestFor(t *testing.T) {
+	value := signUp{Name: "bob", Score: 1.5}
+	s := For(gtml.NewApp(), &value, nil)
+
+	// the inputs are populated from the struct
+	got := s.Values()
+	want := Values{"name": "bob", "age": "", "score": "1.5", "terms": "false"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got values %v, want %v", got, want)
+	}
+
+	// the unchecked box is missing
+	if err := field(t, s.form, "terms").Error(); !strings.Contains(err, "checked") {
+		t.Errorf("got terms error %q, want the checked error", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		values Values
+		want   signUp
+		err    string
+	}{
+		{
+			name:   "valid",
+			values: Values{"name": "alice", "age": "-12", "score": "2.25", "terms": "true"},
+			want:   signUp{Name: "alice", Age: -12, Score: 2.25, Terms: true},
+		},
+		{
+			name:   "empty number",
+			values: Values{"name": "alice", "age": "", "score": "", "terms": "false"},
+			want:   signUp{Name: "alice"},
+		},
+		{
+			name:   "overflow",
+			values: Values{"name": "alice", "age": "300", "score": "", "terms": "true"},
+			err:    "age",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var value signUp
+			s := For(gtml.NewApp(), &value, nil)
+
+			for name, v := range test.values {
+				field(t, s.form, name).SetValue(v)
+			}
+
+			err := s.Decode()
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("got error %v, want a %s error", err, test.err)
+				}
+				// the value is checked before it's decoded
+				if err := field(t, s.form, test.err).Error(); !strings.Contains(err, "valid int8") {
+					t.Errorf("got field error %q, want the int8 error", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.want {
+				t.Errorf("got %+v, want %+v", value, test.want)
+			}
+		})
+	}
+}
+
+func TestInputs(t *testing.T) {
+	app := gtml.NewApp()
+	value := signUp{Name: "bob", Terms: true}
+	s := For(app, &value, nil)
+
+	inputs := mount(app, elements.Div()(s.Inputs()...)).Children[0]
+
+	types := []string{}
+	for _, div := range inputs.Children {
+		input := div.Children[0].Children[1]
+		types = append(types, input.Attributes["type"])
+	}
+
+	if got, want := strings.Join(types, ","), "text,number,number,checkbox"; got != want {
+		t.Errorf("got inputs %s, want %s", got, want)
+	}
+
+	terms := inputs.Children[3].Children[0].Children[1]
+	if checked := elements.GetDOM().GetProperty(terms, "checked"); checked != true {
+		t.Errorf("the terms checkbox is checked = %v, want true", checked)
+	}
+}
@@ -19,18 +19,25 @@ func NewApp() *App {
 	}
 }
 
-func (a *App) UseState(v interface{}) *State {
-	s := &State{
-		channel: make(chan interface{}),
-		value:   nil,
-	}
+// Scope is where states, computed states and effects are created,
+// it's either the App, where they live as long as the app, or a
+// component Ctx, where they are kept between renders and
+// disposed when the component is unmounted
+type Scope interface {
+	stateManager() *StateManager
+	// hook returns the value created by create at the first
+	// render, the same value is returned at the next ones
+	hook(create func() interface{}) interface{}
+	// own registers a function called when the scope is disposed
+	own(dispose func())
+}
 
-	a.StateManager.appendState(s)
+func (a *App) stateManager() *StateManager { return a.StateManager }
 
-	s.channel <- v
+func (a *App) hook(create func() interface{}) interface{} { return create() }
 
-	return s
-}
+// everything created in the app is disposed by the state manager
+func (a *App) own(dispose func()) {}
 
 // this function will be used to give App main element
 func (a *App) Use(el elements.Element) *App {
@@ -96,40 +103,72 @@ func (s statement) Value() elements.Element {
 	return s.el
 }
 
+// For returns the elements of fn called with every number from
+// init to reached (included), counting down if reached is lower,
+// every element is keyed with its number (unless fn gives it a key)
+// so the rows are moved instead of being patched when the range changes
 func For(
 	init, reached int,
 ) func(fn func(int) elements.Element) *elements.SliceEl {
-	sliceElement := &elements.SliceEl{}
+	return func(fn func(int) elements.Element) *elements.SliceEl {
+		sliceElement := &elements.SliceEl{}
 
-	reverse := reached > init
+		step := 1
+		if reached < init {
+			step = -1
+		}
 
-	return func(fn func(int) elements.Element) *elements.SliceEl {
-		if reverse {
-			for i := init; i >= reached; i-- {
-				sliceElement.AppendChild(fn(i))
+		for i := init; i != reached+step; i += step {
+			el := fn(i)
+			if el.GetKey() == nil {
+				elements.SetKey(el, i)
 			}
-		}
 
-		for i := init; i <= reached; i++ {
-			sliceElement.AppendChild(fn(i))
+			sliceElement.AppendChild(el)
 		}
 
 		return sliceElement
 	}
 }
 
+// EachFunc renders the elements of a slice, see Each
+type EachFunc func(fn func(int, any) elements.Element) *elements.SliceEl
+
+// Keyed returns the same list with every element keyed with keyFn
+// called with the item, so when the list is updated rows are moved,
+// inserted or removed by key and keep their dom state
+//
+// example:
+//
+//	gtml.Each(users).Keyed(func(user any) any {
+//		return user.(User).ID
+//	})(func(i int, user any) Element {
+//		return Li()(Text(user.(User).Name))
+//	})
+func (each EachFunc) Keyed(keyFn func(item any) any) EachFunc {
+	return func(fn func(int, any) elements.Element) *elements.SliceEl {
+		return each(func(i int, item any) elements.Element {
+			el := fn(i, item)
+			elements.SetKey(el, keyFn(item))
+
+			return el
+		})
+	}
+}
+
 func Each(
 	slice interface{},
-) func(fn func(int, any) elements.Element) *elements.SliceEl {
-	sliceElement := &elements.SliceEl{}
+) EachFunc {
 	return func(fn func(int, any) elements.Element) *elements.SliceEl {
+		sliceElement := &elements.SliceEl{}
+
 		if reflect.TypeOf(slice).Kind() != reflect.Slice {
 			log.Println("argument must be a slice")
 			return sliceElement
 		}
 
 		s := reflect.ValueOf(slice)
-		for i := 0; i <= s.Len(); i++ {
+		for i := 0; i < s.Len(); i++ {
 			sliceElement.AppendChild(
 				fn(i, s.Index(i).Interface()),
 			)
@@ -142,8 +181,9 @@ func Each(
 func Each2[T any](
 	slice []T,
 ) func(fn func(int, T) elements.Element) *elements.SliceEl {
-	sliceElement := &elements.SliceEl{}
 	return func(fn func(int, T) elements.Element) *elements.SliceEl {
+		sliceElement := &elements.SliceEl{}
+
 		for i := 0; i < len(slice); i++ {
 			sliceElement.AppendChild(
 				fn(i, slice[i]),
@@ -153,3 +193,24 @@ func Each2[T any](
 		return sliceElement
 	}
 }
+
+// EachKeyed works like Each2 but every element is keyed with
+// keyFn, so when the list is updated rows are moved, inserted
+// or removed by key and keep their dom state
+func EachKeyed[T any, K comparable](
+	slice []T,
+	keyFn func(T) K,
+) func(fn func(int, T) elements.Element) *elements.SliceEl {
+	return func(fn func(int, T) elements.Element) *elements.SliceEl {
+		sliceElement := &elements.SliceEl{}
+
+		for i := 0; i < len(slice); i++ {
+			el := fn(i, slice[i])
+			elements.SetKey(el, keyFn(slice[i]))
+
+			sliceElement.AppendChild(el)
+		}
+
+		return sliceElement
+	}
+}
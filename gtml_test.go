@@ -0,0 +1,170 @@
+package gtml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+// childs returns the text and the key of every child of the slice
+func childs(slice *elements.SliceEl) ([]string, []interface{}) {
+	texts, keys := []string{}, []interface{}{}
+	for _, child := range slice.GetChilds() {
+		var b strings.Builder
+		elements.Render(child, &b)
+
+		texts = append(texts, b.String())
+		keys = append(keys, child.GetKey())
+	}
+
+	return texts, keys
+}
+
+func TestLists(t *testing.T) {
+	li := func(text string) elements.Element { return elements.Li()(elements.Text(text)) }
+
+	tests := []struct {
+		name  string
+		slice *elements.SliceEl
+		texts string
+		keys  []interface{}
+	}{
+		{
+			name: "For counts up",
+			slice: For(1, 3)(func(i int) elements.Element {
+				return li(strings.Repeat("x", i))
+			}),
+			texts: "<li>x</li>,<li>xx</li>,<li>xxx</li>",
+			keys:  []interface{}{1, 2, 3},
+		},
+		{
+			name: "For counts down",
+			slice: For(3, 1)(func(i int) elements.Element {
+				return li(strings.Repeat("x", i))
+			}),
+			texts: "<li>xxx</li>,<li>xx</li>,<li>x</li>",
+			keys:  []interface{}{3, 2, 1},
+		},
+		{
+			name: "For keeps the given keys",
+			slice: For(1, 1)(func(i int) elements.Element {
+				return elements.Li(elements.Key("one"))()
+			}),
+			texts: "<li></li>",
+			keys:  []interface{}{"one"},
+		},
+		{
+			name: "Each",
+			slice: Each([]string{"a", "b"})(func(i int, item any) elements.Element {
+				return li(item.(string))
+			}),
+			texts: "<li>a</li>,<li>b</li>",
+			keys:  []interface{}{nil, nil},
+		},
+		{
+			name: "Each keyed",
+			slice: Each([]string{"a", "b"}).Keyed(func(item any) any {
+				return "key-" + item.(string)
+			})(func(i int, item any) elements.Element {
+				return li(item.(string))
+			}),
+			texts: "<li>a</li>,<li>b</li>",
+			keys:  []interface{}{"key-a", "key-b"},
+		},
+		{
+			name: "Each2",
+			slice: Each2([]int{4, 5})(func(i int, item int) elements.Element {
+				return li(strings.Repeat("x", item))
+			}),
+			texts: "<li>xxxx</li>,<li>xxxxx</li>",
+			keys:  []interface{}{nil, nil},
+		},
+		{
+			name: "EachKeyed",
+			slice: EachKeyed([]string{"a", "b"}, strings.ToUpper)(func(i int, item string) elements.Element {
+				return li(item)
+			}),
+			texts: "<li>a</li>,<li>b</li>",
+			keys:  []interface{}{"A", "B"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			texts, keys := childs(test.slice)
+
+			if got := strings.Join(texts, ","); got != test.texts {
+				t.Errorf("got %s, want %s", got, test.texts)
+			}
+
+			if len(keys) != len(test.keys) {
+				t.Fatalf("got %d keys, want %d", len(keys), len(test.keys))
+			}
+			for i := range keys {
+				if keys[i] != test.keys[i] {
+					t.Errorf("key %d is %v, want %v", i, keys[i], test.keys[i])
+				}
+			}
+		})
+	}
+}
+
+func TestListsRenderedAgain(t *testing.T) {
+	item := func(i int) elements.Element { return elements.Li()(elements.Textf("%d", i)) }
+	anyItem := func(_ int, v any) elements.Element { return item(v.(int)) }
+	intItem := func(_ int, v int) elements.Element { return item(v) }
+
+	// the lists are created once and rendered at each render of the parent
+	forList := For(1, 2)
+	eachList := Each([]int{1, 2})
+	each2List := Each2([]int{1, 2})
+	keyedList := EachKeyed([]int{1, 2}, func(v int) int { return v })
+
+	tests := []struct {
+		name   string
+		render func() *elements.SliceEl
+	}{
+		{name: "For", render: func() *elements.SliceEl { return forList(item) }},
+		{name: "Each", render: func() *elements.SliceEl { return eachList(anyItem) }},
+		{name: "Each keyed", render: func() *elements.SliceEl {
+			return eachList.Keyed(func(v any) any { return v })(anyItem)
+		}},
+		{name: "Each2", render: func() *elements.SliceEl { return each2List(intItem) }},
+		{name: "EachKeyed", render: func() *elements.SliceEl { return keyedList(intItem) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.render()
+
+			if got := len(test.render().GetChilds()); got != 2 {
+				t.Errorf("got %d childs at the second render, want 2", got)
+			}
+		})
+	}
+}
+
+func TestForMovesKeyedRows(t *testing.T) {
+	d := elements.NewMemoryDOM()
+	elements.SetDOM(d)
+
+	rows := func(from, to int) elements.Element {
+		return elements.Ul()(For(from, to)(func(i int) elements.Element {
+			return elements.Li()(elements.Textf("%d", i))
+		}))
+	}
+
+	before := rows(1, 3)
+	elements.Mount(before, d.Body())
+
+	ul := d.Body().(*elements.MemoryNode).Children[0]
+	// the first child is the fragment start anchor
+	first := ul.Children[1]
+
+	elements.Patch(before, rows(0, 3))
+
+	if got := ul.Children[2]; got != first {
+		t.Errorf("row 1 was rebuilt instead of being moved")
+	}
+}
@@ -0,0 +1,214 @@
+// Package head manages the document head (title, meta, link and style tags)
+// from the components, the tags are applied to the document head in
+// the browser and captured to be written in the page when prerendering
+package head
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// KeyAttribute identifies the tags managed by the head package
+// in the page, so a prerendered tag is updated instead of duplicated
+const KeyAttribute = "data-gtml-head"
+
+// Attr is an attribute of a head tag
+type Attr struct {
+	Name  string
+	Value string
+}
+
+// Tag is a head tag, only one tag is kept by Key (e.g. the last
+// description set replaces the previous one)
+type Tag struct {
+	Key        string
+	Name       string
+	Attributes []Attr
+	// Text is the content of the tag (e.g. the title or the css of a style)
+	Text string
+}
+
+// HTML returns the html of the tag
+func (t Tag) HTML() string {
+	var b strings.Builder
+
+	b.WriteString("<" + t.Name)
+	for _, attr := range t.Attributes {
+		fmt.Fprintf(&b, ` %s="%s"`, attr.Name, html.EscapeString(attr.Value))
+	}
+
+	if t.Name != "title" {
+		fmt.Fprintf(&b, ` %s="%s"`, KeyAttribute, html.EscapeString(t.Key))
+	}
+	b.WriteString(">")
+
+	switch t.Name {
+	case "title":
+		b.WriteString(html.EscapeString(t.Text) + "</title>")
+
+	case "style":
+		// the css isn't escaped, only a closing tag can't be in it
+		b.WriteString(strings.ReplaceAll(t.Text, "</", `<\/`) + "</style>")
+	}
+
+	return b.String()
+}
+
+var (
+	mutex sync.Mutex
+	// tags set outside of Capture
+	tags = make(map[string]Tag)
+	// capturing is the tags set while Capture runs, nil otherwise
+	capturing    map[string]Tag
+	captureMutex sync.Mutex
+)
+
+// Set sets the tag, replacing the one with the same key
+func Set(tag Tag) {
+	mutex.Lock()
+	if capturing != nil {
+		capturing[tag.Key] = tag
+		mutex.Unlock()
+		return
+	}
+
+	// the same tag isn't applied again (e.g. a
+	// stylesheet set each time it's used)
+	if old, ok := tags[tag.Key]; ok && old.HTML() == tag.HTML() {
+		mutex.Unlock()
+		return
+	}
+
+	tags[tag.Key] = tag
+	mutex.Unlock()
+
+	// applied to the document head in the browser
+	apply(tag)
+}
+
+// Title sets the document title
+func Title(title string) {
+	Set(Tag{Key: "title", Name: "title", Text: title})
+}
+
+// Meta sets the meta tag with the given name (e.g. "description")
+func Meta(name, content string) {
+	Set(Tag{
+		Key:  "meta:name:" + name,
+		Name: "meta",
+		Attributes: []Attr{
+			{Name: "name", Value: name},
+			{Name: "content", Value: content},
+		},
+	})
+}
+
+// Property sets the meta tag with the given property,
+// it's used by the Open Graph tags (e.g. "og:title")
+func Property(property, content string) {
+	Set(Tag{
+		Key:  "meta:property:" + property,
+		Name: "meta",
+		Attributes: []Attr{
+			{Name: "property", Value: property},
+			{Name: "content", Value: content},
+		},
+	})
+}
+
+// Link sets a link tag, links are identified by rel and href
+// (e.g. several stylesheets), see Canonical for a single link
+func Link(rel, href string) {
+	Set(Tag{
+		Key:  "link:" + rel + ":" + href,
+		Name: "link",
+		Attributes: []Attr{
+			{Name: "rel", Value: rel},
+			{Name: "href", Value: href},
+		},
+	})
+}
+
+// Canonical sets the canonical link of the page
+func Canonical(href string) {
+	Set(Tag{
+		Key:  "link:canonical",
+		Name: "link",
+		Attributes: []Attr{
+			{Name: "rel", Value: "canonical"},
+			{Name: "href", Value: href},
+		},
+	})
+}
+
+// Style sets a style tag with the given css, styles are identified
+// by key (e.g. the stylesheets of the css package)
+func Style(key, css string) {
+	Set(Tag{
+		Key:  "style:" + key,
+		Name: "style",
+		Text: css,
+	})
+}
+
+// Tags returns the tags set outside of Capture, sorted by key
+func Tags() []Tag {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return sorted(tags)
+}
+
+// Capture returns the tags set while fn runs (e.g. while a page
+// is rendered on the server), they are not applied to the document
+//
+// NOTE: the captures are serialized, so concurrent
+// renders don't mix their tags
+func Capture(fn func()) []Tag {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	mutex.Lock()
+	capturing = make(map[string]Tag)
+	mutex.Unlock()
+
+	defer func() {
+		mutex.Lock()
+		capturing = nil
+		mutex.Unlock()
+	}()
+
+	fn()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return sorted(capturing)
+}
+
+// Merge returns the tags of every lists, the tags of
+// the last lists replace the ones with the same key
+func Merge(lists ...[]Tag) []Tag {
+	merged := make(map[string]Tag)
+	for _, list := range lists {
+		for _, tag := range list {
+			merged[tag.Key] = tag
+		}
+	}
+
+	return sorted(merged)
+}
+
+func sorted(tags map[string]Tag) []Tag {
+	list := make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		list = append(list, tag)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+
+	return list
+}
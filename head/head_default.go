@@ -0,0 +1,7 @@
+//go:build !(js && wasm)
+
+package head
+
+// there is no document head outside of the browser,
+// the tags are only kept for Tags
+func apply(tag Tag) {}
@@ -0,0 +1,49 @@
+//go:build js && wasm
+
+package head
+
+import "syscall/js"
+
+// apply updates the tag with the same key in the document
+// head, or adds it if there is none yet
+func apply(tag Tag) {
+	document := js.Global().Get("document")
+
+	if tag.Name == "title" {
+		document.Set("title", tag.Text)
+		return
+	}
+
+	headEl := document.Get("head")
+
+	var el js.Value
+	for _, node := range nodes(headEl.Call("getElementsByTagName", tag.Name)) {
+		if node.Call("getAttribute", KeyAttribute).Equal(js.ValueOf(tag.Key)) {
+			el = node
+			break
+		}
+	}
+
+	if el.IsUndefined() {
+		el = document.Call("createElement", tag.Name)
+		el.Call("setAttribute", KeyAttribute, tag.Key)
+		headEl.Call("appendChild", el)
+	}
+
+	for _, attr := range tag.Attributes {
+		el.Call("setAttribute", attr.Name, attr.Value)
+	}
+
+	if tag.Text != "" {
+		el.Set("textContent", tag.Text)
+	}
+}
+
+func nodes(collection js.Value) []js.Value {
+	list := make([]js.Value, collection.Length())
+	for i := range list {
+		list[i] = collection.Index(i)
+	}
+
+	return list
+}
@@ -0,0 +1,127 @@
+package head
+
+import (
+	"strings"
+	"testing"
+)
+
+// keys returns the keys of the tags
+func keys(tags []Tag) string {
+	list := make([]string, len(tags))
+	for i, tag := range tags {
+		list[i] = tag.Key
+	}
+
+	return strings.Join(list, ",")
+}
+
+// find returns the tag with the key, if any
+func find(tags []Tag, key string) (Tag, bool) {
+	for _, tag := range tags {
+		if tag.Key == key {
+			return tag, true
+		}
+	}
+
+	return Tag{}, false
+}
+
+func TestTagHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  Tag
+		want string
+	}{
+		{
+			name: "title is escaped",
+			tag:  Tag{Key: "title", Name: "title", Text: "a <b>"},
+			want: `<title>a &lt;b&gt;</title>`,
+		},
+		{
+			name: "attributes are escaped and the key is written",
+			tag: Tag{Key: "meta:name:description", Name: "meta", Attributes: []Attr{
+				{Name: "name", Value: "description"},
+				{Name: "content", Value: `"a" & b`},
+			}},
+			want: `<meta name="description" content="&#34;a&#34; &amp; b" data-gtml-head="meta:name:description">`,
+		},
+		{
+			name: "style can't be closed by its css",
+			tag:  Tag{Key: "style:a", Name: "style", Text: "a{} </style><script>"},
+			want: `<style data-gtml-head="style:a">a{} <\/style><script></style>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.tag.HTML(); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCapture(t *testing.T) {
+	tags := Capture(func() {
+		Title("first")
+		Meta("description", "a page")
+		Link("stylesheet", "/b.css")
+		Link("stylesheet", "/a.css")
+		// the last tag with the same key is kept
+		Title("second")
+	})
+
+	if got, want := keys(tags), "link:stylesheet:/a.css,link:stylesheet:/b.css,meta:name:description,title"; got != want {
+		t.Errorf("got tags %s, want %s", got, want)
+	}
+
+	if title, _ := find(tags, "title"); title.Text != "second" {
+		t.Errorf("got title %s, want second", title.Text)
+	}
+
+	// the captured tags are not global
+	if _, ok := find(Tags(), "meta:name:description"); ok {
+		t.Error("the captured description leaked outside of the capture")
+	}
+}
+
+func TestTags(t *testing.T) {
+	Style("head-test", "a { color: red; }")
+	Style("head-test", "a { color: blue; }")
+
+	tag, ok := find(Tags(), "style:head-test")
+	if !ok {
+		t.Fatal("the style set outside of a capture isn't in the tags")
+	}
+	if tag.Text != "a { color: blue; }" {
+		t.Errorf("got style %s, want the last one", tag.Text)
+	}
+
+	// the global tags are not captured
+	if _, ok := find(Capture(func() {}), "style:head-test"); ok {
+		t.Error("a global tag is in the capture")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	defaults := []Tag{
+		{Key: "title", Name: "title", Text: "default"},
+		{Key: "meta:name:author", Name: "meta"},
+	}
+	page := []Tag{{Key: "title", Name: "title", Text: "page"}}
+	rendered := []Tag{{Key: "meta:name:description", Name: "meta"}}
+
+	tags := Merge(defaults, page, rendered)
+
+	if got, want := keys(tags), "meta:name:author,meta:name:description,title"; got != want {
+		t.Errorf("got tags %s, want %s", got, want)
+	}
+
+	// the last lists replace the first ones
+	if title, _ := find(tags, "title"); title.Text != "page" {
+		t.Errorf("got title %s, want page", title.Text)
+	}
+	if title, _ := find(Merge(page, defaults), "title"); title.Text != "default" {
+		t.Errorf("got title %s, want default", title.Text)
+	}
+}
@@ -0,0 +1,218 @@
+package gtml
+
+import (
+	"sync"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+// observer is something that reads states (e.g. a reactive element)
+// and that should run again when one of them changes
+type observer struct {
+	manager *StateManager
+	run     func()
+	// invalidated is called right away when a dependency changes
+	// (instead of run at the next flush), it returns the signal
+	// to invalidate in turn, it's used by the computed states
+	invalidated func() *signal
+	// cleanup is called when the observer is disposed
+	cleanup  func()
+	states   map[*signal]struct{}
+	disposed bool
+}
+
+// newObserver creates an observer registered in the state
+// manager, so it's disposed when the state manager stops
+func (m *StateManager) newObserver(run func()) *observer {
+	o := &observer{
+		manager: m,
+		run:     run,
+		states:  make(map[*signal]struct{}),
+	}
+
+	m.mutex.Lock()
+	m.observers[o] = struct{}{}
+	m.mutex.Unlock()
+
+	return o
+}
+
+// track runs fn with the observer as the current one, so every
+// state read by fn is recorded as one of its dependencies
+func (o *observer) track(fn func()) {
+	m := o.manager
+
+	// forget the previous dependencies, fn may read others
+	o.clear()
+
+	m.mutex.Lock()
+	m.tracking = append(m.tracking, o)
+	m.mutex.Unlock()
+
+	defer func() {
+		m.mutex.Lock()
+		m.tracking = m.tracking[:len(m.tracking)-1]
+		m.mutex.Unlock()
+	}()
+
+	fn()
+}
+
+func (o *observer) clear() {
+	o.manager.mutex.Lock()
+	defer o.manager.mutex.Unlock()
+
+	for s := range o.states {
+		delete(s.observers, o)
+	}
+	o.states = make(map[*signal]struct{})
+}
+
+func (o *observer) dispose() {
+	o.clear()
+
+	o.manager.mutex.Lock()
+	if o.disposed {
+		o.manager.mutex.Unlock()
+		return
+	}
+
+	o.disposed = true
+	delete(o.manager.pending, o)
+	delete(o.manager.observers, o)
+	cleanup := o.cleanup
+	o.manager.mutex.Unlock()
+
+	if cleanup != nil {
+		cleanup()
+	}
+}
+
+// Reactive returns an element rendered by fn, when a state read
+// by fn changes, fn is called again (batched by the state manager
+// scheduler) and only this part of the tree is patched
+func (a *App) Reactive(fn func() elements.Element) elements.Element {
+	var el elements.Element
+
+	dynamic := &elements.DynamicEl{}
+
+	o := a.StateManager.newObserver(func() { elements.Update(dynamic) })
+
+	dynamic.Render = func() elements.Element {
+		o.track(func() { el = fn() })
+		return el
+	}
+	dynamic.Dispose = o.dispose
+
+	return dynamic
+}
+
+// ComputedState is a read only state derived from other states,
+// see Computed
+type ComputedState[T any] struct {
+	signal
+	mutex    sync.RWMutex
+	value    T
+	fn       func() T
+	manager  *StateManager
+	observer *observer
+	dirty    bool
+}
+
+// Computed returns a state derived from the states read by fn, it's
+// computed lazily when it's read and computed again only if one of
+// those states changed since, reading it in a reactive element or
+// another computed state works like reading a normal state
+func Computed[T any](scope Scope, fn func() T) *ComputedState[T] {
+	return scope.hook(func() interface{} {
+		m := scope.stateManager()
+
+		c := &ComputedState[T]{
+			fn:      fn,
+			manager: m,
+			dirty:   true,
+		}
+
+		m.mutex.Lock()
+		c.observers = make(map[*observer]struct{})
+		m.mutex.Unlock()
+
+		c.observer = m.newObserver(nil)
+		c.observer.invalidated = func() *signal {
+			// already dirty, observers are already invalidated
+			if c.dirty {
+				return nil
+			}
+
+			c.dirty = true
+			return &c.signal
+		}
+		scope.own(c.observer.dispose)
+
+		return c
+	}).(*ComputedState[T])
+}
+
+// Get returns the computed value, computing it if needed
+func (c *ComputedState[T]) Get() T {
+	c.manager.track(&c.signal)
+
+	c.manager.mutex.RLock()
+	dirty, disposed := c.dirty, c.observer.disposed
+	c.manager.mutex.RUnlock()
+
+	// once disposed (e.g. after Stop) the dependencies are not
+	// tracked anymore, so the value is computed at each read
+	if disposed {
+		return c.fn()
+	}
+
+	if dirty {
+		var v T
+		c.observer.track(func() { v = c.fn() })
+
+		c.mutex.Lock()
+		c.value = v
+		c.mutex.Unlock()
+
+		c.manager.mutex.Lock()
+		c.dirty = false
+		c.manager.mutex.Unlock()
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.value
+}
+
+// Effect calls fn right away and again each time one of the states
+// it read changes (batched by the state manager scheduler), the
+// cleanup function returned by fn (can be nil) is called before
+// the next call and when the effect is disposed, the returned
+// function disposes the effect, in a component the effect is
+// created at the first render and disposed on unmount
+func Effect(scope Scope, fn func() (cleanup func())) func() {
+	return scope.hook(func() interface{} {
+		var cleanup func()
+
+		runCleanup := func() {
+			if cleanup != nil {
+				cleanup()
+				cleanup = nil
+			}
+		}
+
+		var o *observer
+		o = scope.stateManager().newObserver(func() {
+			runCleanup()
+			o.track(func() { cleanup = fn() })
+		})
+		o.cleanup = runCleanup
+		scope.own(o.dispose)
+
+		o.run()
+
+		return o.dispose
+	}).(func())
+}
@@ -0,0 +1,64 @@
+package gtml
+
+import "testing"
+
+func TestComputed(t *testing.T) {
+	app := NewApp()
+	a := UseState(app, 1)
+
+	computes := 0
+	double := Computed(app, func() int {
+		computes++
+		return a.Get() * 2
+	})
+
+	app.StateManager.Start()
+
+	tests := []struct {
+		name     string
+		set      func()
+		want     int
+		computes int
+	}{
+		{name: "computed at the first read", set: func() {}, want: 2, computes: 1},
+		{name: "not computed again without changes", set: func() {}, want: 2, computes: 1},
+		{name: "computed again after a change", set: func() { a.Set(5) }, want: 10, computes: 2},
+		{name: "computed after stop", set: func() { app.StateManager.Stop(); a.Set(7) }, want: 14, computes: 3},
+	}
+
+	for _, test := range tests {
+		test.set()
+
+		if got := double.Get(); got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+		if computes != test.computes {
+			t.Errorf("%s: computed %d times, want %d", test.name, computes, test.computes)
+		}
+	}
+}
+
+func TestEffect(t *testing.T) {
+	app := NewApp()
+	a := UseState(app, 0)
+
+	runs, cleanups := []int{}, 0
+	dispose := Effect(app, func() func() {
+		runs = append(runs, a.Get())
+		return func() { cleanups++ }
+	})
+
+	app.StateManager.Start()
+
+	a.Set(1)
+	a.Set(2)
+	dispose()
+	a.Set(3)
+
+	if len(runs) != 3 || runs[0] != 0 || runs[1] != 1 || runs[2] != 2 {
+		t.Errorf("got runs %v, want [0 1 2]", runs)
+	}
+	if cleanups != 3 {
+		t.Errorf("got %d cleanups, want 3", cleanups)
+	}
+}
@@ -0,0 +1,98 @@
+package router
+
+import "sync"
+
+// History is where the router reads and changes the current
+// location, in the browser it's the history api, elsewhere
+// (e.g. when prerendering) it's a MemoryHistory
+type History interface {
+	// Location returns the current path with its query (e.g. "/users/1?tab=posts")
+	Location() string
+	// Push navigates to path and adds an entry to the history
+	Push(path string)
+	// Replace navigates to path and replaces the current entry
+	Replace(path string)
+	// Back goes back to the previous entry, if any
+	Back()
+	// Listen calls fn with the new location when it's changed by the
+	// user (e.g. the back button), not by Push or Replace, the
+	// returned function removes the listener
+	Listen(fn func(location string)) (stop func())
+}
+
+// MemoryHistory is an history kept in memory, it's the
+// default one when the app is not running in the browser
+type MemoryHistory struct {
+	mutex     sync.Mutex
+	entries   []string
+	listeners map[int]func(string)
+	nextID    int
+}
+
+func NewMemoryHistory(location string) *MemoryHistory {
+	return &MemoryHistory{
+		entries:   []string{location},
+		listeners: make(map[int]func(string)),
+	}
+}
+
+func (h *MemoryHistory) Location() string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.entries[len(h.entries)-1]
+}
+
+func (h *MemoryHistory) Push(path string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.entries = append(h.entries, path)
+}
+
+func (h *MemoryHistory) Replace(path string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.entries[len(h.entries)-1] = path
+}
+
+// Back removes the current entry and calls the
+// listeners like the browser popstate event
+func (h *MemoryHistory) Back() {
+	h.mutex.Lock()
+	if len(h.entries) == 1 {
+		h.mutex.Unlock()
+		return
+	}
+
+	h.entries = h.entries[:len(h.entries)-1]
+	location := h.entries[len(h.entries)-1]
+
+	listeners := make([]func(string), 0, len(h.listeners))
+	for _, listener := range h.listeners {
+		listeners = append(listeners, listener)
+	}
+	h.mutex.Unlock()
+
+	for _, listener := range listeners {
+		listener(location)
+	}
+}
+
+func (h *MemoryHistory) Listen(fn func(location string)) func() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	id := h.nextID
+	h.nextID++
+
+	h.listeners[id] = fn
+
+	return func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+
+		delete(h.listeners, id)
+	}
+}
@@ -0,0 +1,5 @@
+//go:build !(js && wasm)
+
+package router
+
+func defaultHistory() History { return NewMemoryHistory("/") }
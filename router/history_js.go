@@ -0,0 +1,44 @@
+//go:build js && wasm
+
+package router
+
+import "syscall/js"
+
+// BrowserHistory is the history api of the browser, the
+// router listens to the popstate event to follow the
+// back and forward buttons
+type BrowserHistory struct{}
+
+func defaultHistory() History { return BrowserHistory{} }
+
+func (BrowserHistory) Location() string {
+	location := js.Global().Get("location")
+	return location.Get("pathname").String() + location.Get("search").String()
+}
+
+func (BrowserHistory) Push(path string) {
+	js.Global().Get("history").Call("pushState", js.Null(), "", path)
+}
+
+func (BrowserHistory) Replace(path string) {
+	js.Global().Get("history").Call("replaceState", js.Null(), "", path)
+}
+
+func (BrowserHistory) Back() {
+	js.Global().Get("history").Call("back")
+}
+
+func (h BrowserHistory) Listen(fn func(location string)) func() {
+	listener := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		fn(h.Location())
+		return nil
+	})
+
+	window := js.Global()
+	window.Call("addEventListener", "popstate", listener)
+
+	return func() {
+		window.Call("removeEventListener", "popstate", listener)
+		listener.Release()
+	}
+}
@@ -0,0 +1,263 @@
+package router
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// Component renders a route, it receives the route
+// context to read the path and query parameters
+type Component func(route *Context) elements.Element
+
+// RouteDef is a route of the router, see Route
+type RouteDef struct {
+	pattern   string
+	segments  []string
+	component Component
+	children  []*RouteDef
+}
+
+// Route returns a route rendering component when the path matches
+// pattern, a segment starting with ":" is a path parameter and a
+// "*" segment matches the rest of the path, the children routes
+// patterns are relative to pattern and the matched child is
+// rendered where the component puts route.Outlet()
+//
+// example:
+//
+//	router.Route("/users", Users,
+//		router.Route("/", UsersList),
+//		router.Route("/:id", User),
+//	)
+func Route(pattern string, component Component, children ...*RouteDef) *RouteDef {
+	return &RouteDef{
+		pattern:   pattern,
+		segments:  splitPath(pattern),
+		component: component,
+		children:  children,
+	}
+}
+
+// Context is given to the components of the matched routes
+type Context struct {
+	Router *Router
+	// Path is the current path, without the query
+	Path   string
+	params map[string]string
+	query  url.Values
+	outlet elements.Element
+}
+
+// Param returns the path parameter (e.g. "id" for "/users/:id"),
+// the path matched by "*" is the "*" parameter
+func (c *Context) Param(name string) string { return c.params[name] }
+
+// Params returns every path parameters
+func (c *Context) Params() map[string]string { return c.params }
+
+// Query returns the first value of the query parameter
+func (c *Context) Query(name string) string { return c.query.Get(name) }
+
+// QueryValues returns every query parameters
+func (c *Context) QueryValues() url.Values { return c.query }
+
+// Outlet returns the element of the matched child route,
+// it's empty if no child route matched
+func (c *Context) Outlet() elements.Element { return c.outlet }
+
+// Router renders the route matching the current location, the
+// location is a state so navigating only renders the router view
+type Router struct {
+	app      *gtml.App
+	history  History
+	routes   []*RouteDef
+	notFound Component
+	location *gtml.State[string]
+}
+
+// New returns a router using the browser history in
+// wasm and a memory history everywhere else
+func New(app *gtml.App, routes ...*RouteDef) *Router {
+	return NewWithHistory(app, defaultHistory(), routes...)
+}
+
+func NewWithHistory(app *gtml.App, history History, routes ...*RouteDef) *Router {
+	r := &Router{
+		app:     app,
+		history: history,
+		routes:  routes,
+		notFound: func(route *Context) elements.Element {
+			return elements.Text("404 page not found")
+		},
+		location: gtml.UseState(app, history.Location()),
+	}
+
+	// follows the back and forward buttons, the
+	// listener is removed when the app stops
+	gtml.Effect(app, func() func() {
+		return history.Listen(r.location.Set)
+	})
+
+	return r
+}
+
+// NotFound changes the component rendered when no route matches
+func (r *Router) NotFound(component Component) *Router {
+	r.notFound = component
+
+	return r
+}
+
+// Location returns the current path with its query
+func (r *Router) Location() string { return r.location.Get() }
+
+// Navigate goes to path, adding an entry to the history
+func (r *Router) Navigate(path string) {
+	r.history.Push(path)
+	r.location.Set(path)
+}
+
+// Replace goes to path, replacing the current history entry
+func (r *Router) Replace(path string) {
+	r.history.Replace(path)
+	r.location.Set(path)
+}
+
+// Back goes back to the previous history entry
+func (r *Router) Back() { r.history.Back() }
+
+// View returns the element rendering the matched routes,
+// it's rendered again each time the location changes
+func (r *Router) View() elements.Element {
+	return r.app.Reactive(func() elements.Element {
+		return r.render(r.location.Get())
+	})
+}
+
+// Link returns an anchor navigating to href with the router instead
+// of loading the page, clicks with a modifier key (e.g. to open a
+// new tab) or another button are left to the browser
+//
+// NOTE: the OnClick attribute is set by the link
+func (r *Router) Link(href string, attributes ...elements.Attribute) func(...elements.Element) elements.Element {
+	attributes = append(
+		attributes,
+		elements.Href(href),
+		elements.OnClick(func(e elements.MouseEvent) {
+			if e.Button() != 0 || e.CtrlKey() || e.MetaKey() || e.ShiftKey() || e.AltKey() {
+				return
+			}
+
+			e.PreventDefault()
+			r.Navigate(href)
+		}),
+	)
+
+	return elements.A(attributes...)
+}
+
+func (r *Router) render(location string) elements.Element {
+	u, err := url.Parse(location)
+	if err != nil {
+		u = &url.URL{Path: location}
+	}
+
+	params := make(map[string]string)
+	matched := match(r.routes, splitPath(u.Path), params)
+
+	newContext := func(outlet elements.Element) *Context {
+		return &Context{
+			Router: r,
+			Path:   u.Path,
+			params: params,
+			query:  u.Query(),
+			outlet: outlet,
+		}
+	}
+
+	if matched == nil {
+		return r.notFound(newContext(&elements.EmptyEl{}))
+	}
+
+	// the deepest route is rendered first, it's
+	// the outlet of its parent route and so on
+	var el elements.Element = &elements.EmptyEl{}
+	for i := len(matched) - 1; i >= 0; i-- {
+		if matched[i].component != nil {
+			el = matched[i].component(newContext(el))
+		}
+	}
+
+	return el
+}
+
+// match returns the routes matching the path segments from the
+// top level route to the deepest one, and nil if none matched
+func match(routes []*RouteDef, segments []string, params map[string]string) []*RouteDef {
+	for _, route := range routes {
+		routeParams := make(map[string]string)
+
+		consumed, ok := matchSegments(route.segments, segments, routeParams)
+		if !ok {
+			continue
+		}
+
+		rest := segments[consumed:]
+
+		var matched []*RouteDef
+		if len(route.children) > 0 {
+			matched = match(route.children, rest, routeParams)
+		}
+
+		if matched == nil && len(rest) > 0 {
+			continue
+		}
+
+		for name, value := range routeParams {
+			params[name] = value
+		}
+
+		return append([]*RouteDef{route}, matched...)
+	}
+
+	return nil
+}
+
+// matchSegments matches the beginning of the path with the
+// pattern, it returns the number of path segments matched
+func matchSegments(pattern, segments []string, params map[string]string) (int, bool) {
+	for i, segment := range pattern {
+		if segment == "*" {
+			params["*"] = strings.Join(segments[i:], "/")
+			return len(segments), true
+		}
+
+		if i >= len(segments) {
+			return 0, false
+		}
+
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			params[segment[1:]] = segments[i]
+
+		case segment != segments[i]:
+			return 0, false
+		}
+	}
+
+	return len(pattern), true
+}
+
+func splitPath(path string) []string {
+	segments := []string{}
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
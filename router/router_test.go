@@ -0,0 +1,153 @@
+package router
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// text returns a component rendering the text of fn
+func text(fn func(route *Context) string) Component {
+	return func(route *Context) elements.Element {
+		return elements.Text(fn(route))
+	}
+}
+
+// layout returns a component rendering its name and its outlet
+func layout(name string) Component {
+	return func(route *Context) elements.Element {
+		return elements.Div()(elements.Text(name+" "), route.Outlet())
+	}
+}
+
+// memoryText returns the text of every text node of n
+func memoryText(n *elements.MemoryNode) string {
+	var b strings.Builder
+
+	b.WriteString(n.Text)
+	for _, child := range n.Children {
+		b.WriteString(memoryText(child))
+	}
+
+	return b.String()
+}
+
+func newRouter(location string) *Router {
+	return NewWithHistory(gtml.NewApp(), NewMemoryHistory(location),
+		Route("/", text(func(*Context) string { return "home" })),
+		Route("/users", layout("users"),
+			Route("/", text(func(*Context) string { return "list" })),
+			Route("/:id", func(route *Context) elements.Element {
+				return elements.Span()(elements.Text("user "+route.Param("id")), route.Outlet())
+			},
+				Route("/posts/:post", text(func(route *Context) string {
+					return "post " + route.Param("post")
+				})),
+			),
+		),
+		Route("/files/*", text(func(route *Context) string { return "file " + route.Param("*") })),
+		Route("/search", text(func(route *Context) string {
+			return "search " + route.Query("q") + " " + strings.Join(route.QueryValues()["tag"], ",")
+		})),
+	)
+}
+
+func TestRouter(t *testing.T) {
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{location: "/", want: "home"},
+		{location: "/users", want: "<div>users list</div>"},
+		{location: "/users/", want: "<div>users list</div>"},
+		{location: "/users/42", want: "<div>users <span>user 42</span></div>"},
+		// each child route is rendered where its parent puts the outlet
+		{location: "/users/42/posts/7", want: "<div>users <span>user 42post 7</span></div>"},
+		{location: "/files/a/b.txt", want: "file a/b.txt"},
+		{location: "/files", want: "file "},
+		{location: "/search?q=go&tag=a&tag=b", want: "search go a,b"},
+		{location: "/missing", want: "404 page not found"},
+		{location: "/users/42/missing", want: "404 page not found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.location, func(t *testing.T) {
+			r := newRouter(test.location)
+
+			var b strings.Builder
+			if err := elements.Render(r.render(test.location), &b); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := b.String(); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRouterParams(t *testing.T) {
+	var params map[string]string
+
+	r := NewWithHistory(gtml.NewApp(), NewMemoryHistory("/"),
+		Route("/orgs/:org", layout("org"),
+			Route("/repos/:repo", func(route *Context) elements.Element {
+				params = route.Params()
+				return &elements.EmptyEl{}
+			}),
+		),
+	)
+	r.render("/orgs/golang/repos/go")
+
+	if params["org"] != "golang" || params["repo"] != "go" {
+		t.Errorf("got params %v, want org golang and repo go", params)
+	}
+}
+
+func TestRouterNotFound(t *testing.T) {
+	r := newRouter("/").NotFound(text(func(route *Context) string {
+		return "no page at " + route.Path
+	}))
+
+	var b strings.Builder
+	elements.Render(r.render("/missing?a=1"), &b)
+
+	if got, want := b.String(), "no page at /missing"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestRouterNavigate(t *testing.T) {
+	r := newRouter("/")
+
+	d := elements.NewMemoryDOM()
+	elements.SetDOM(d)
+	elements.Mount(elements.Div()(r.View()), d.Body())
+	r.app.StateManager.Start()
+
+	body := d.Body().(*elements.MemoryNode)
+	view := func() string { return memoryText(body) }
+
+	steps := []struct {
+		name string
+		do   func()
+		want string
+	}{
+		{name: "start", do: func() {}, want: "home"},
+		{name: "navigate", do: func() { r.Navigate("/users/1") }, want: "users user 1"},
+		{name: "replace", do: func() { r.Replace("/users/2") }, want: "users user 2"},
+		{name: "navigate again", do: func() { r.Navigate("/search?q=x") }, want: "search x "},
+		// the replaced entry is the previous one
+		{name: "back", do: r.Back, want: "users user 2"},
+	}
+
+	for _, step := range steps {
+		step.do()
+
+		if got := view(); got != step.want {
+			t.Errorf("%s: got %q, want %q", step.name, got, step.want)
+		}
+	}
+}
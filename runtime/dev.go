@@ -0,0 +1,80 @@
+//go:build js && wasm
+
+package runtime
+
+import (
+	"log"
+	"sync"
+	"syscall/js"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/document"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// the states are saved in the session storage by app target
+const stateKeyPrefix = "gtml:state:"
+
+var (
+	// mounted apps by target, their states are saved by gtmlSaveState
+	mountedApps      = make(map[string]*gtml.App)
+	mountedAppsMutex sync.Mutex
+	saveStateOnce    sync.Once
+)
+
+func isDev() bool { return js.Global().Get(document.DevGlobal).Truthy() }
+
+// restoreState restores the app states saved before the reload
+func restoreState(app *gtml.App, target string) {
+	storage := js.Global().Get("sessionStorage")
+
+	data := storage.Call("getItem", stateKeyPrefix+target)
+	if data.IsNull() {
+		return
+	}
+	storage.Call("removeItem", stateKeyPrefix+target)
+
+	if err := app.StateManager.Restore([]byte(data.String())); err != nil {
+		log.Println(err)
+	}
+}
+
+// devMount enables the development checks and keeps
+// the app states between the reloads
+func devMount(app *gtml.App, target string) {
+	elements.DevMode = true
+
+	restoreState(app, target)
+
+	mountedAppsMutex.Lock()
+	mountedApps[target] = app
+	mountedAppsMutex.Unlock()
+
+	saveStateOnce.Do(func() {
+		// never released, it lives as long as the page
+		js.Global().Set(document.SaveStateFunc, js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			mountedAppsMutex.Lock()
+			defer mountedAppsMutex.Unlock()
+
+			storage := js.Global().Get("sessionStorage")
+			for target, app := range mountedApps {
+				data, err := app.StateManager.Save()
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+
+				storage.Call("setItem", stateKeyPrefix+target, string(data))
+			}
+
+			return nil
+		}))
+	})
+}
+
+func devUnmount(target string) {
+	mountedAppsMutex.Lock()
+	defer mountedAppsMutex.Unlock()
+
+	delete(mountedApps, target)
+}
@@ -0,0 +1,132 @@
+//go:build js && wasm
+
+package runtime
+
+import (
+	"fmt"
+	"syscall/js"
+
+	"github.com/4lxprime/gtml"
+	"github.com/4lxprime/gtml/elements"
+)
+
+// MountedApp is an app built in the page, see Mount
+type MountedApp struct {
+	App    *gtml.App
+	Parent elements.Node
+	target string
+}
+
+// Mount builds the app in the element matching target, either a css
+// selector or an element id, and starts its state manager, several
+// apps can be mounted in the same page, each one in its own element
+//
+// NOTE: Mount waits for the document to be loaded, so it must not be
+// called in a javascript callback, and the main function must not
+// return once the apps are mounted (e.g. with a select {})
+//
+// example:
+//
+//	runtime.Mount(header, "#header")
+//	runtime.Mount(counter, "#counter")
+//
+//	select {}
+func Mount(app *gtml.App, target string) (*MountedApp, error) {
+	return mount(app, target, elements.Mount)
+}
+
+// Hydrate works like Mount but the app adopts the nodes already in
+// the element matching target (e.g. a page prerendered on the
+// server with elements.Render) instead of building them again
+//
+// NOTE: set elements.DevMode to log the nodes not matching the app
+func Hydrate(app *gtml.App, target string) (*MountedApp, error) {
+	return mount(app, target, elements.Hydrate)
+}
+
+// build hydrates the nodes already in parent if it has some (e.g. a
+// page prerendered by the ssg package), else it builds the element
+func build(element elements.Element, parent elements.Node) elements.Node {
+	d := elements.GetDOM()
+
+	for _, node := range d.ChildNodes(parent) {
+		switch d.NodeName(node) {
+		// the indentation, the comments and the page
+		// scripts are not server-rendered markup
+		case "#text", "#comment", "script":
+
+		default:
+			return elements.Hydrate(element, parent)
+		}
+	}
+
+	return elements.Mount(element, parent)
+}
+
+func mount(
+	app *gtml.App,
+	target string,
+	build func(elements.Element, elements.Node) elements.Node,
+) (*MountedApp, error) {
+	waitDocument()
+
+	parent := elements.Query(target)
+	if parent == nil {
+		return nil, fmt.Errorf("no element matches %s", target)
+	}
+
+	// states changes are batched and flushed at the next animation frame
+	app.StateManager.SetScheduler(func(flush func()) {
+		var frame js.Func
+		frame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			frame.Release()
+			flush()
+			return nil
+		})
+
+		js.Global().Call("requestAnimationFrame", frame)
+	})
+
+	if isDev() {
+		devMount(app, target)
+	}
+
+	build(app.Element, parent)
+	app.StateManager.Start()
+
+	return &MountedApp{
+		App:    app,
+		Parent: parent,
+		target: target,
+	}, nil
+}
+
+// Unmount stops the app state manager, removes the app
+// nodes from the page and releases their listeners
+func (m *MountedApp) Unmount() {
+	devUnmount(m.target)
+
+	m.App.StateManager.Stop()
+	elements.Unmount(m.App.Element)
+}
+
+// waitDocument blocks until the document is parsed
+func waitDocument() {
+	document := js.Global().Get("document")
+	if document.Get("readyState").String() != "loading" {
+		return
+	}
+
+	loaded := make(chan struct{})
+
+	var listener js.Func
+	listener = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		document.Call("removeEventListener", "DOMContentLoaded", listener)
+		listener.Release()
+		close(loaded)
+		return nil
+	})
+	document.Call("addEventListener", "DOMContentLoaded", listener)
+
+	<-loaded
+}
@@ -1,22 +1,28 @@
+//go:build js && wasm
+
 package runtime
 
 import (
+	"log"
 	"syscall/js"
 
 	"github.com/4lxprime/gtml"
-	"github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/document"
 )
 
-func SetFunc(name string, fn func()) {
-	js.Global().Set(
-		name,
-		js.FuncOf(
-			func(this js.Value, args []js.Value) interface{} {
-				fn()
-				return nil
-			},
-		),
+// SetFunc sets a global javascript function calling fn, the
+// returned js.Func must be released when it's not used anymore
+func SetFunc(name string, fn func()) js.Func {
+	jsFunc := js.FuncOf(
+		func(this js.Value, args []js.Value) interface{} {
+			fn()
+			return nil
+		},
 	)
+
+	js.Global().Set(name, jsFunc)
+
+	return jsFunc
 }
 
 // todo: test this one
@@ -31,39 +37,73 @@ func Runtime(appElement *gtml.App) {
 	stopch := make(chan struct{})
 	loadch := make(chan struct{})
 
+	// every js functions, released when the app stops
+	funcs := []js.Func{}
+
 	// javascript stop function, should be called by the wasm page
-	SetFunc("stop", func() {
+	funcs = append(funcs, SetFunc(document.StopFunc, func() {
 		close(stopch)
-	})
+	}))
 
 	// javascript end loading function, should be called by the wasm page
-	SetFunc("loaded", func() {
+	funcs = append(funcs, SetFunc(document.LoadedFunc, func() {
 		close(loadch)
-	})
+	}))
 
 	// ---------------- App ---->
 	// NOTE: this will be called at page load
 	// when the wasm code will  be executed in the client
 	// -------------------------
 
-	js.Global().Set(
-		"app",
-		elements.Build(
-			appElement.Element,
-		),
-	)
+	// the mounted app is given back to the main goroutine
+	mountedch := make(chan *MountedApp, 1)
+
+	funcs = append(funcs, SetFunc(document.AppFunc, func() {
+		// Mount may wait for the document, it can't
+		// block the javascript callback
+		go func() {
+			// the body prerendered by the document or
+			// ssg packages is hydrated instead of rebuilt
+			m, err := mount(appElement, "body", build)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+
+			mountedch <- m
+			js.Global().Call(document.LoadedFunc)
+		}()
+	}))
 
 	// ---------------- State Manager ---->
 
-	// state manager start function
-	SetFunc("stateManagerStart", func() {
-		go appElement.StateManager.Start()
-	})
 	// state manager stop function
-	SetFunc("stateManagerStop", func() {
+	funcs = append(funcs, SetFunc(document.StateManagerStopFunc, func() {
+		appElement.StateManager.Stop()
+	}))
+
+	var mounted *MountedApp
+	select {
+	case mounted = <-mountedch:
+		<-stopch
+
+	case <-stopch:
+		// the app may have been mounted in the meantime
+		select {
+		case mounted = <-mountedch:
+		default:
+		}
+	}
+
+	// stop the state manager, remove the app
+	// and release every js functions
+	if mounted != nil {
+		mounted.Unmount()
+	} else {
 		appElement.StateManager.Stop()
-	})
+	}
 
-	<-stopch
-	js.Global().Call("stateManagerStop")
+	for _, jsFunc := range funcs {
+		jsFunc.Release()
+	}
 }
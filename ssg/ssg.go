@@ -0,0 +1,140 @@
+// Package ssg renders gtml pages to static html files, the pages
+// don't need wasm unless they are marked interactive
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/4lxprime/gtml/document"
+	"github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/head"
+	"github.com/4lxprime/gtml/internal/files"
+)
+
+// Page is a page of the site
+type Page struct {
+	// Path is the url path of the page (e.g. "/" or "/blog/hello"),
+	// it's written to path/index.html, or to path if it ends with .html
+	Path  string
+	Title string
+	// Render returns the page body content
+	Render func() elements.Element
+	// Interactive pages load the wasm app, which should hydrate
+	// the prerendered body (see runtime.Hydrate)
+	Interactive bool
+}
+
+// Site is a set of pages built in an output directory
+//
+// example:
+//
+//	site := &ssg.Site{
+//		Output: "dist",
+//		Assets: "static",
+//		Pages: []ssg.Page{
+//			{Path: "/", Title: "Home", Render: Home},
+//			{Path: "/counter", Title: "Counter", Render: Counter, Interactive: true},
+//		},
+//	}
+//
+//	if err := site.Build(); err != nil {
+//		log.Fatal(err)
+//	}
+type Site struct {
+	Pages []Page
+	// Output is the directory in which the site is built
+	Output string
+	// Assets is a directory copied as is in Output (can be empty)
+	Assets string
+	// Lang is the html lang attribute (default: "en")
+	Lang string
+	// Wasm and WasmExec are the urls of the wasm app and of the go
+	// wasm support script loaded by the interactive pages
+	// (default: "/main.wasm" and "/wasm_exec.js")
+	Wasm     string
+	WasmExec string
+}
+
+// Build copies the assets and renders every page in the output directory
+func (s *Site) Build() error {
+	if err := os.MkdirAll(s.Output, 0o755); err != nil {
+		return err
+	}
+
+	if s.Assets != "" {
+		if err := files.CopyDir(s.Assets, s.Output); err != nil {
+			return fmt.Errorf("copy assets: %w", err)
+		}
+	}
+
+	for _, page := range s.Pages {
+		if err := s.buildPage(page); err != nil {
+			return fmt.Errorf("page %s: %w", page.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Site) buildPage(page Page) error {
+	path := filepath.Join(s.Output, pageFile(page.Path))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := s.RenderPage(page, &buf); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// RenderPage writes the whole html document of the page into w
+func (s *Site) RenderPage(page Page, w io.Writer) error {
+	doc := document.New(page.Title)
+	doc.Lang = s.Lang
+	doc.Wasm = ""
+
+	// the head tags set while the tree is created, the ones set
+	// while it's rendered are captured by the document
+	doc.Head.Tags = head.Capture(func() {
+		if page.Render != nil {
+			doc.Body = page.Render()
+		}
+	})
+
+	// the wasm bootstrap is only injected in interactive pages
+	if page.Interactive {
+		doc.Wasm = orDefault(s.Wasm, "/main.wasm")
+		doc.WasmExec = orDefault(s.WasmExec, "/wasm_exec.js")
+	}
+
+	return doc.Render(w)
+}
+
+// pageFile returns the file of the page path
+// (e.g. "/" -> "index.html", "/blog" -> "blog/index.html")
+func pageFile(path string) string {
+	path = strings.Trim(path, "/")
+
+	if strings.HasSuffix(path, ".html") {
+		return filepath.FromSlash(path)
+	}
+
+	return filepath.Join(filepath.FromSlash(path), "index.html")
+}
+
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,151 @@
+package ssg
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/4lxprime/gtml/elements"
+	"github.com/4lxprime/gtml/head"
+)
+
+func TestPageFile(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/", want: "index.html"},
+		{path: "", want: "index.html"},
+		{path: "/blog", want: "blog/index.html"},
+		{path: "/blog/", want: "blog/index.html"},
+		{path: "/blog/hello", want: "blog/hello/index.html"},
+		{path: "/404.html", want: "404.html"},
+		{path: "/docs/page.html", want: "docs/page.html"},
+	}
+
+	for _, test := range tests {
+		if got := pageFile(test.path); got != filepath.FromSlash(test.want) {
+			t.Errorf("pageFile(%q) = %s, want %s", test.path, got, test.want)
+		}
+	}
+}
+
+// writeFile writes the file at path in dir, with its directories
+func writeFile(t *testing.T, dir, path, content string) {
+	t.Helper()
+
+	path = filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readFile returns the content of the file at path in dir
+func readFile(t *testing.T, dir, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestBuild(t *testing.T) {
+	assets := t.TempDir()
+	writeFile(t, assets, "style.css", "body {}")
+	writeFile(t, assets, "img/logo.svg", "<svg></svg>")
+
+	site := &Site{
+		Output: filepath.Join(t.TempDir(), "dist"),
+		Assets: assets,
+		Pages: []Page{
+			{Path: "/", Title: "Home", Render: func() elements.Element {
+				return elements.H1()(elements.Text("home"))
+			}},
+			{Path: "/blog/hello", Title: "Hello", Render: func() elements.Element {
+				return elements.P()(elements.Text("hello"))
+			}},
+			{Path: "/counter", Title: "Counter", Interactive: true, Render: func() elements.Element {
+				return elements.Button()(elements.Text("0"))
+			}},
+		},
+	}
+
+	if err := site.Build(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the assets are copied as is
+	for path, want := range map[string]string{"style.css": "body {}", "img/logo.svg": "<svg></svg>"} {
+		if got := readFile(t, site.Output, path); got != want {
+			t.Errorf("%s is %q, want %q", path, got, want)
+		}
+	}
+
+	tests := []struct {
+		file        string
+		contains    []string
+		interactive bool
+	}{
+		{file: "index.html", contains: []string{"<title>Home</title>", "<body><h1>home</h1></body>"}},
+		{file: "blog/hello/index.html", contains: []string{"<title>Hello</title>", "<body><p>hello</p></body>"}},
+		{file: "counter/index.html", contains: []string{"<body><button>0</button></body>", `<script src="/wasm_exec.js">`}, interactive: true},
+	}
+
+	for _, test := range tests {
+		page := readFile(t, site.Output, test.file)
+
+		for _, s := range test.contains {
+			if !strings.Contains(page, s) {
+				t.Errorf("%s doesn't contain %s:\n%s", test.file, s, page)
+			}
+		}
+
+		// only the interactive pages load the wasm app
+		if got := strings.Contains(page, "/main.wasm"); got != test.interactive {
+			t.Errorf("%s loads the wasm app = %v, want %v", test.file, got, test.interactive)
+		}
+	}
+}
+
+func TestRenderPageHead(t *testing.T) {
+	site := &Site{Wasm: "/app.wasm", WasmExec: "/js/wasm_exec.js"}
+
+	var b strings.Builder
+	err := site.RenderPage(Page{
+		Title:       "default",
+		Interactive: true,
+		Render: func() elements.Element {
+			// set while the tree is created
+			head.Meta("description", "a page")
+			return elements.Div()(elements.Text("page"))
+		},
+	}, &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page := b.String()
+	for _, s := range []string{
+		`<meta name="description" content="a page"`,
+		`<script src="/js/wasm_exec.js">`,
+		`fetch("/app.wasm")`,
+	} {
+		if !strings.Contains(page, s) {
+			t.Errorf("the page doesn't contain %s:\n%s", s, page)
+		}
+	}
+
+	// the tags captured for the page are not global
+	for _, tag := range head.Tags() {
+		if tag.Key == "meta:name:description" {
+			t.Error("the page description leaked outside of the page")
+		}
+	}
+}
@@ -1,83 +1,413 @@
 package gtml
 
 import (
-	"context"
+	"encoding/json"
+	"fmt"
+	"log"
 	"sync"
+
+	"github.com/4lxprime/gtml/elements"
 )
 
-// the state represents a reactive value in the main component
+// signal is the non generic part of a state, it's what the
+// observers depend on and what the state manager tracks
 //
 // NOTE: id is used by the state manager in the runtime
-// and when something is pushed through the channel,
-// this data will be placed on value field
-type State struct {
-	id      int64
-	started bool
-	channel chan interface{}
-	value   interface{}
+type signal struct {
+	id        int64
+	observers map[*observer]struct{}
+}
+
+// the state represents a reactive value of type T, reading it
+// while rendering a reactive element makes the element render
+// again when the value changes
+type State[T any] struct {
+	signal
+	mutex          sync.RWMutex
+	value          T
+	manager        *StateManager
+	subscribers    map[int64]func(T)
+	nextSubscriber int64
+}
+
+// UseState creates a new state with the initial value and registers
+// it in the scope state manager, in a component the same state is
+// returned at each render
+func UseState[T any](scope Scope, initial T) *State[T] {
+	return scope.hook(func() interface{} {
+		m := scope.stateManager()
+
+		s := &State[T]{
+			value:       initial,
+			manager:     m,
+			subscribers: make(map[int64]func(T)),
+		}
+
+		m.appendState(s)
+		scope.own(func() { m.removeState(s) })
+
+		return s
+	}).(*State[T])
+}
+
+// Get returns the state value without blocking, if it's called while
+// rendering a reactive element, the element will be rendered again
+// when the state changes
+func (s *State[T]) Get() T {
+	s.manager.track(&s.signal)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.value
+}
+
+// Peek returns the state value without tracking it, reading it
+// while rendering a reactive element doesn't render it again
+func (s *State[T]) Peek() T {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.value
+}
+
+// Set changes the state value, calls the subscribers and
+// schedules a render of every element that read the state
+func (s *State[T]) Set(v T) {
+	s.mutex.Lock()
+	s.value = v
+	subscribers := make([]func(T), 0, len(s.subscribers))
+	for _, subscriber := range s.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	s.mutex.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber(v)
+	}
+
+	s.manager.invalidate(&s.signal)
+}
+
+// Update sets the state value to the result of fn called
+// with the current value (e.g. to increment a counter)
+func (s *State[T]) Update(fn func(T) T) {
+	s.mutex.RLock()
+	v := s.value
+	s.mutex.RUnlock()
+
+	s.Set(fn(v))
+}
+
+// Subscribe calls fn with the new value each time the state
+// is set, the returned function removes the subscription
+func (s *State[T]) Subscribe(fn func(T)) func() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextSubscriber
+	s.nextSubscriber++
+
+	s.subscribers[id] = fn
+
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		delete(s.subscribers, id)
+	}
+}
+
+func (s *State[T]) getSignal() *signal { return &s.signal }
+
+func (s *State[T]) save() (json.RawMessage, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return json.Marshal(s.value)
 }
 
-func (s *State) Get() interface{} {
-	if !s.started {
-		return s.value
+func (s *State[T]) restore(data json.RawMessage) error {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
 	}
 
-	return <-s.channel
+	s.Set(v)
+
+	return nil
 }
 
-func (s *State) Set(v interface{}) { s.channel <- v }
+func (s *State[T]) unsubscribeAll() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.subscribers = make(map[int64]func(T))
+}
+
+// state is implemented by every State whatever its type,
+// so the state manager can keep them together
+type state interface {
+	getSignal() *signal
+	unsubscribeAll()
+	save() (json.RawMessage, error)
+	restore(data json.RawMessage) error
+}
 
 type StateManager struct {
-	states map[int64]*State
-	mutex  sync.RWMutex
-	ctx    context.Context
-	cancel context.CancelFunc
+	states  map[int64]state
+	nextID  int64
+	mutex   sync.RWMutex
+	started bool
+	stopped bool
+	// every observers (reactive elements, computed states
+	// and effects) so they are disposed on stop
+	observers map[*observer]struct{}
+	// observers currently tracking the states they read
+	tracking []*observer
+	// observers that must run at the next flush
+	pending   map[*observer]struct{}
+	scheduled bool
+	scheduler func(flush func())
+	// restored values of the states not created yet (e.g.
+	// the component states), set when they are created
+	restored map[int64]json.RawMessage
 }
 
 func NewStateManager() *StateManager {
-	ctx, cancel := context.WithCancel(context.Background())
 	return &StateManager{
-		states: make(map[int64]*State),
-		ctx:    ctx,
-		cancel: cancel,
+		states:    make(map[int64]state),
+		observers: make(map[*observer]struct{}),
+		pending:   make(map[*observer]struct{}),
+		restored:  make(map[int64]json.RawMessage),
+		// by default changes are flushed right away, or once
+		// the running patch is done if a state is set during it
+		scheduler: elements.AfterPatch,
 	}
 }
 
-func (m *StateManager) appendState(s *State) {
-	var id int64 = int64(len(m.states))
+// SetScheduler changes how the state manager flushes the changes,
+// the scheduler receives the flush function and should call it later
+// (e.g. at the next animation frame) so changes are batched
+func (m *StateManager) SetScheduler(scheduler func(flush func())) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.scheduler = scheduler
+}
+
+func (m *StateManager) appendState(s state) {
+	m.mutex.Lock()
 
-	s.id = id
+	id := m.nextID
+	m.nextID++
+
+	sig := s.getSignal()
+	sig.id = id
+	sig.observers = make(map[*observer]struct{})
 
 	m.states[id] = s
 
-	go m.listenState(s)
+	value, restored := m.restored[id]
+	delete(m.restored, id)
+	m.mutex.Unlock()
+
+	if restored {
+		if err := s.restore(value); err != nil {
+			log.Printf("state %d: %v", id, err)
+		}
+	}
+}
+
+func (m *StateManager) removeState(s state) {
+	s.unsubscribeAll()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.states, s.getSignal().id)
 }
 
+// Start is called once the app is built, changes made
+// before are flushed and the next ones are scheduled
 func (m *StateManager) Start() {
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+	m.mutex.Lock()
+	m.started = true
+	m.mutex.Unlock()
 
-	for _, state := range m.states {
-		state.started = true
+	m.schedule()
+}
+
+// track records the state as a dependency of the current observer
+func (m *StateManager) track(sig *signal) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(m.tracking) == 0 {
+		return
 	}
+
+	o := m.tracking[len(m.tracking)-1]
+	o.states[sig] = struct{}{}
+	sig.observers[o] = struct{}{}
 }
 
-func (m *StateManager) listenState(s *State) {
-	for {
-		select {
-		case <-m.ctx.Done():
-			return
+// invalidate schedules a flush of every observer of the state
+func (m *StateManager) invalidate(sig *signal) {
+	m.mutex.Lock()
+	if m.stopped {
+		m.mutex.Unlock()
+		return
+	}
+
+	m.invalidateSignal(sig)
+	m.mutex.Unlock()
+
+	m.schedule()
+}
 
-		case val, ok := <-s.channel:
-			if !ok {
-				m.mutex.Lock()
-				delete(m.states, s.id)
-				m.mutex.Unlock()
-				return
+// invalidateSignal marks every observer of the signal as pending,
+// computed states are invalidated right away with their observers
+//
+// NOTE: the mutex must be locked
+func (m *StateManager) invalidateSignal(sig *signal) {
+	for o := range sig.observers {
+		if o.invalidated != nil {
+			if next := o.invalidated(); next != nil {
+				m.invalidateSignal(next)
 			}
-			s.value = val
+			continue
+		}
+
+		m.pending[o] = struct{}{}
+	}
+}
+
+func (m *StateManager) schedule() {
+	m.mutex.Lock()
+
+	if !m.started || m.stopped || m.scheduled || len(m.pending) == 0 {
+		m.mutex.Unlock()
+		return
+	}
+
+	m.scheduled = true
+	scheduler := m.scheduler
+	m.mutex.Unlock()
+
+	scheduler(m.flush)
+}
+
+// flush runs every pending observer
+func (m *StateManager) flush() {
+	m.mutex.Lock()
+	pending := m.pending
+	m.pending = make(map[*observer]struct{})
+	m.scheduled = false
+	m.mutex.Unlock()
+
+	for o := range pending {
+		m.mutex.RLock()
+		disposed := o.disposed
+		m.mutex.RUnlock()
+
+		if !disposed {
+			o.run()
 		}
 	}
 }
 
-func (m *StateManager) Stop() { m.cancel() }
+// Stop disposes every reactive elements, computed states and
+// effects and removes every states subscriptions, the states can
+// still be used but nothing is rendered anymore
+func (m *StateManager) Stop() {
+	m.mutex.Lock()
+	observers := make([]*observer, 0, len(m.observers))
+	for o := range m.observers {
+		observers = append(observers, o)
+	}
+	m.mutex.Unlock()
+
+	for _, o := range observers {
+		o.dispose()
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.stopped = true
+	m.pending = make(map[*observer]struct{})
+
+	for _, s := range m.states {
+		s.unsubscribeAll()
+
+		sig := s.getSignal()
+		for o := range sig.observers {
+			delete(o.states, sig)
+		}
+		sig.observers = make(map[*observer]struct{})
+	}
+}
+
+// Save returns the value of every states encoded in json, it's used
+// to keep the states when the page is reloaded in development
+//
+// NOTE: the states are identified by their creation order, so
+// they must be created in the same order by the next app
+func (m *StateManager) Save() ([]byte, error) {
+	m.mutex.RLock()
+	states := make(map[int64]state, len(m.states))
+	for id, s := range m.states {
+		states[id] = s
+	}
+	m.mutex.RUnlock()
+
+	values := make(map[int64]json.RawMessage, len(states))
+	for id, s := range states {
+		value, err := s.save()
+		if err != nil {
+			return nil, fmt.Errorf("state %d: %w", id, err)
+		}
+
+		values[id] = value
+	}
+
+	return json.Marshal(values)
+}
+
+// Restore sets the states to the values given by Save, the values
+// that don't fit their state anymore (e.g. the code changed) are
+// skipped and reported in the returned error
+//
+// NOTE: the states created later (e.g. the component states created
+// at their first render) get their value when they are created
+func (m *StateManager) Restore(data []byte) error {
+	values := make(map[int64]json.RawMessage)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	var errs []error
+	for id, value := range values {
+		m.mutex.Lock()
+		s, ok := m.states[id]
+		if !ok {
+			m.restored[id] = value
+		}
+		m.mutex.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		if err := s.restore(value); err != nil {
+			errs = append(errs, fmt.Errorf("state %d: %w", id, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("can't restore every states: %v", errs)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package gtml
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/4lxprime/gtml/elements"
+)
+
+// mountApp builds the element in a new memory backend
+// and starts the app state manager
+func mountApp(app *App, el elements.Element) *elements.MemoryNode {
+	d := elements.NewMemoryDOM()
+	elements.SetDOM(d)
+
+	elements.Mount(el, d.Body())
+	app.StateManager.Start()
+
+	return d.Body().(*elements.MemoryNode)
+}
+
+// text returns the text of every text node of n
+func text(n *elements.MemoryNode) string {
+	if n.Tag == "#text" {
+		return n.Text
+	}
+
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(text(child))
+	}
+
+	return b.String()
+}
+
+// noDeadlock fails the test if fn doesn't return
+func noDeadlock(t *testing.T, fn func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("deadlock")
+	}
+}
+
+func TestReactiveRendersOnSet(t *testing.T) {
+	app := NewApp()
+	count := UseState(app, 0)
+
+	body := mountApp(app, elements.Div()(app.Reactive(func() elements.Element {
+		return elements.Textf("%d", count.Get())
+	})))
+
+	count.Set(1)
+	count.Update(func(v int) int { return v + 1 })
+
+	if got := text(body); got != "2" {
+		t.Errorf("got %s, want 2", got)
+	}
+}
+
+func TestSetWhilePatching(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(app *App) (*elements.MemoryNode, func())
+		want string
+	}{
+		{
+			name: "a reactive element sets a state read by another one",
+			run: func(app *App) (*elements.MemoryNode, func()) {
+				a, b := UseState(app, 0), UseState(app, 0)
+
+				body := mountApp(app, elements.Div()(
+					app.Reactive(func() elements.Element {
+						v := a.Get()
+						b.Set(v * 2)
+						return elements.Textf("a%d ", v)
+					}),
+					app.Reactive(func() elements.Element {
+						return elements.Textf("b%d", b.Get())
+					}),
+				))
+
+				return body, func() { a.Set(1) }
+			},
+			want: "a1 b2",
+		},
+		{
+			name: "an effect sets a state while a reactive element renders",
+			run: func(app *App) (*elements.MemoryNode, func()) {
+				a, b := UseState(app, 0), UseState(app, 0)
+
+				Effect(app, func() func() {
+					b.Set(a.Get() + 10)
+					return nil
+				})
+
+				body := mountApp(app, elements.Div()(app.Reactive(func() elements.Element {
+					return elements.Textf("a%d b%d", a.Get(), b.Get())
+				})))
+
+				return body, func() { a.Set(1) }
+			},
+			want: "a1 b11",
+		},
+		{
+			name: "a component reads a state and then sets it",
+			run: func(app *App) (*elements.MemoryNode, func()) {
+				n := UseState(app, 0)
+
+				Counter := Component(app, func(ctx *Ctx, _ struct{}) elements.Element {
+					v := n.Get()
+					if v > 0 && v < 3 {
+						n.Set(v + 1)
+					}
+					return elements.Textf("%d", v)
+				})
+
+				body := mountApp(app, elements.Div()(Counter(struct{}{})))
+
+				return body, func() { n.Set(1) }
+			},
+			want: "3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := NewApp()
+
+			noDeadlock(t, func() {
+				body, set := test.run(app)
+				set()
+
+				if got := text(body); got != test.want {
+					t.Errorf("got %s, want %s", got, test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestSaveRestore(t *testing.T) {
+	// newApp builds the same app at each reload
+	newApp := func() (*App, *State[string], elements.Element) {
+		app := NewApp()
+		title := UseState(app, "title")
+
+		Counter := Component(app, func(ctx *Ctx, _ struct{}) elements.Element {
+			count := UseState(ctx, 0)
+
+			return elements.Button(elements.OnClick(func() {
+				count.Update(func(c int) int { return c + 1 })
+			}))(elements.Textf("%d", count.Get()))
+		})
+
+		return app, title, elements.Div()(
+			app.Reactive(func() elements.Element {
+				return elements.Textf("%s ", title.Get())
+			}),
+			Counter(struct{}{}),
+		)
+	}
+
+	app, title, el := newApp()
+	body := mountApp(app, el)
+
+	title.Set("changed")
+	body.Children[0].Children[1].Dispatch("click")
+
+	data, err := app.StateManager.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the component state doesn't exist before its first render
+	reloaded, _, el := newApp()
+	if err := reloaded.StateManager.Restore(data); err != nil {
+		t.Fatal(err)
+	}
+	body = mountApp(reloaded, el)
+
+	if got := text(body); got != "changed 1" {
+		t.Errorf("got %s, want changed 1", got)
+	}
+}